@@ -0,0 +1,138 @@
+package str
+
+import (
+	"math"
+	"regexp"
+	"strings"
+)
+
+// Alignment selects how Align positions each line.
+type Alignment int
+
+const (
+	AlignLeft Alignment = iota
+	AlignRight
+	AlignCenter
+	AlignJustify
+)
+
+var paragraphSeparator = regexp.MustCompile(`\n[ \t\r]*\n\s*`)
+
+// Lay out text as paragraphs of the given display width with the given alignment.
+//
+// Paragraphs are separated by blank lines; the words of each paragraph are
+// re-flowed using minimum-raggedness line breaking, which chooses the breaks
+// that minimize the sum of squared trailing space over all lines but the last
+// (in the style of Knuth and Plass) rather than filling lines greedily.
+// Justified lines spread the extra space evenly between words, leaving the
+// last line of each paragraph left aligned. Words wider than the width are
+// placed on a line of their own. A width of zero or less returns the value
+// unchanged.
+func Align(value string, width int, align Alignment) string {
+	if width <= 0 {
+		return value
+	}
+
+	paragraphs := paragraphSeparator.Split(strings.TrimSpace(value), -1)
+	output := make([]string, 0, len(paragraphs))
+
+	for _, paragraph := range paragraphs {
+		words := strings.Fields(paragraph)
+		lines := breakLines(words, width)
+
+		rendered := make([]string, 0, len(lines))
+		for i, line := range lines {
+			rendered = append(rendered, alignLine(line, width, align, i == len(lines)-1))
+		}
+
+		output = append(output, strings.Join(rendered, "\n"))
+	}
+
+	return strings.Join(output, "\n\n")
+}
+
+// Break words into lines no wider than width with minimum raggedness.
+func breakLines(words []string, width int) [][]string {
+	n := len(words)
+	if n == 0 {
+		return [][]string{}
+	}
+
+	widths := make([]int, n)
+	for i, word := range words {
+		widths[i] = Width(word)
+	}
+
+	// cost[i] is the minimum cost of laying out words[i:], and next[i] the
+	// index of the first word of the line following the one starting at i.
+	cost := make([]float64, n+1)
+	next := make([]int, n+1)
+
+	for i := n - 1; i >= 0; i-- {
+		cost[i] = math.Inf(1)
+		lineWidth := -1
+
+		for j := i + 1; j <= n; j++ {
+			lineWidth += widths[j-1] + 1
+			if lineWidth > width && j > i+1 {
+				break
+			}
+
+			slack := float64(width - lineWidth)
+			badness := slack * slack
+			if j == n || lineWidth > width {
+				// the last line and overlong single words are free
+				badness = 0
+			}
+
+			if badness+cost[j] < cost[i] {
+				cost[i] = badness + cost[j]
+				next[i] = j
+			}
+		}
+	}
+
+	lines := make([][]string, 0)
+	for i := 0; i < n; i = next[i] {
+		lines = append(lines, words[i:next[i]])
+	}
+
+	return lines
+}
+
+// Render the words of a line with the given alignment.
+func alignLine(words []string, width int, align Alignment, last bool) string {
+	line := strings.Join(words, " ")
+
+	switch align {
+	case AlignRight:
+		return WidthPadLeft(line, width, " ")
+	case AlignCenter:
+		return strings.TrimRight(WidthPadBoth(line, width, " "), " ")
+	case AlignJustify:
+		if last || len(words) < 2 {
+			return line
+		}
+
+		gaps := len(words) - 1
+		spaces := width - Width(line) + gaps
+		if spaces <= gaps {
+			return line
+		}
+
+		var builder strings.Builder
+		for i, word := range words {
+			builder.WriteString(word)
+			if i < gaps {
+				count := spaces / gaps
+				if i < spaces%gaps {
+					count++
+				}
+				builder.WriteString(strings.Repeat(" ", count))
+			}
+		}
+		return builder.String()
+	}
+
+	return line
+}
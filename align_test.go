@@ -0,0 +1,38 @@
+package str
+
+import "testing"
+
+func TestAlign(t *testing.T) {
+
+	check := func(value string, width int, align Alignment, expected string) {
+		actual := Align(value, width, align)
+		if actual != expected {
+			t.Errorf("Expected <%q> got <%q>", expected, actual)
+		}
+	}
+
+	// minimum raggedness prefers balanced lines over greedy filling
+	check("aaa bb cc ddddd", 6, AlignLeft, "aaa\nbb cc\nddddd")
+
+	s := "The quick brown fox jumps over the lazy dog."
+
+	check(s, 15, AlignLeft, "The quick brown\nfox jumps over\nthe lazy dog.")
+	check(s, 15, AlignRight, "The quick brown\n fox jumps over\n  the lazy dog.")
+	check(s, 15, AlignCenter, "The quick brown\nfox jumps over\n the lazy dog.")
+	check(s, 15, AlignJustify, "The quick brown\nfox  jumps over\nthe lazy dog.")
+	check(s, 100, AlignJustify, s)
+	check(s, 0, AlignJustify, s)
+	check("", 10, AlignLeft, "")
+
+	// spaces are distributed evenly, extra spaces going to the leftmost gaps
+	check("a b c d e f", 8, AlignJustify, "a  b c d\ne f")
+	check("aa bb cc dd", 10, AlignJustify, "aa  bb  cc\ndd")
+
+	// paragraphs and overlong words
+	check("one two\nthree\n\nfour five six", 9, AlignLeft, "one two\nthree\n\nfour five\nsix")
+	check("a internationalization b", 10, AlignLeft, "a\ninternationalization\nb")
+
+	// display width
+	check("这是 一段 中文 文本", 9, AlignJustify, "这是 一段\n中文 文本")
+	check("这是 一段", 12, AlignRight, "   这是 一段")
+}
@@ -0,0 +1,257 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Groups of characters sharing the same ASCII transliteration.
+var asciiGroups = [][2]string{
+	// Latin, including Scandinavian, Turkish and Vietnamese letters
+	{"A", "ÀÁÂÃÄÅĀĂĄǍǺȀȂȦẠẢẤẦẨẪẬẮẰẲẴẶ"},
+	{"a", "àáâãäåāăąǎǻȁȃȧạảấầẩẫậắằẳẵặª"},
+	{"Ae", "ÆǼ"},
+	{"ae", "æǽ"},
+	{"C", "ÇĆĈĊČ"},
+	{"c", "çćĉċč"},
+	{"D", "ĎĐÐ"},
+	{"d", "ďđð"},
+	{"E", "ÈÉÊËĒĔĖĘĚȄȆẸẺẼẾỀỂỄỆ"},
+	{"e", "èéêëēĕėęěȅȇẹẻẽếềểễệ"},
+	{"f", "ƒ"},
+	{"G", "ĜĞĠĢǦ"},
+	{"g", "ĝğġģǧ"},
+	{"H", "ĤĦ"},
+	{"h", "ĥħ"},
+	{"I", "ÌÍÎÏĨĪĬĮİǏȈȊỈỊ"},
+	{"i", "ìíîïĩīĭįıǐȉȋỉị"},
+	{"Ij", "Ĳ"},
+	{"ij", "ĳ"},
+	{"J", "Ĵ"},
+	{"j", "ĵ"},
+	{"K", "Ķ"},
+	{"k", "ķĸ"},
+	{"L", "ĹĻĽĿŁ"},
+	{"l", "ĺļľŀł"},
+	{"N", "ÑŃŅŇŊ"},
+	{"n", "ñńņňŉŋ"},
+	{"O", "ÒÓÔÕÖØŌŎŐƠǑǾȌȎȪȬȮȰỌỎỐỒỔỖỘỚỜỞỠỢ"},
+	{"o", "òóôõöøōŏőơǒǿȍȏȫȭȯȱọỏốồổỗộớờởỡợº"},
+	{"Oe", "Œ"},
+	{"oe", "œ"},
+	{"R", "ŔŖŘ"},
+	{"r", "ŕŗř"},
+	{"S", "ŚŜŞŠȘ"},
+	{"s", "śŝşšșſ"},
+	{"Ss", "ẞ"},
+	{"ss", "ß"},
+	{"T", "ŢŤŦȚ"},
+	{"t", "ţťŧț"},
+	{"Th", "Þ"},
+	{"th", "þ"},
+	{"U", "ÙÚÛÜŨŪŬŮŰŲƯǓǕǗǙǛȔȖỤỦỨỪỬỮỰ"},
+	{"u", "ùúûüũūŭůűųưǔǖǘǚǜȕȗụủứừửữự"},
+	{"W", "ŴẀẂẄ"},
+	{"w", "ŵẁẃẅ"},
+	{"Y", "ÝŶŸỲỴỶỸ"},
+	{"y", "ýÿŷỳỵỷỹ"},
+	{"Z", "ŹŻŽ"},
+	{"z", "źżž"},
+
+	// Greek
+	{"A", "ΑΆ"},
+	{"a", "αά"},
+	{"V", "Β"},
+	{"v", "β"},
+	{"G", "Γ"},
+	{"g", "γ"},
+	{"D", "Δ"},
+	{"d", "δ"},
+	{"E", "ΕΈ"},
+	{"e", "εέ"},
+	{"Z", "Ζ"},
+	{"z", "ζ"},
+	{"I", "ΗΉΙΊΪ"},
+	{"i", "ηήιίϊΐ"},
+	{"Th", "Θ"},
+	{"th", "θ"},
+	{"K", "Κ"},
+	{"k", "κ"},
+	{"L", "Λ"},
+	{"l", "λ"},
+	{"M", "Μ"},
+	{"m", "μ"},
+	{"N", "Ν"},
+	{"n", "ν"},
+	{"X", "Ξ"},
+	{"x", "ξ"},
+	{"O", "ΟΌΩΏ"},
+	{"o", "οόωώ"},
+	{"P", "Π"},
+	{"p", "π"},
+	{"R", "Ρ"},
+	{"r", "ρ"},
+	{"S", "Σ"},
+	{"s", "σς"},
+	{"T", "Τ"},
+	{"t", "τ"},
+	{"Y", "ΥΎΫ"},
+	{"y", "υύϋΰ"},
+	{"F", "Φ"},
+	{"f", "φ"},
+	{"Ch", "Χ"},
+	{"ch", "χ"},
+	{"Ps", "Ψ"},
+	{"ps", "ψ"},
+
+	// Punctuation and spaces
+	{"'", "‘’‚‛′"},
+	{"\"", "“”„‟″«»"},
+	{"-", "‐‑‒–—―−"},
+	{"...", "…"},
+	{" ", "\u00a0\u2002\u2003\u2009\u202f"},
+}
+
+// Cyrillic letters, covering Russian, Ukrainian, Belarusian, Serbian and Macedonian.
+var cyrillicLetters = map[rune]string{
+	'А': "A", 'а': "a", 'Б': "B", 'б': "b", 'В': "V", 'в': "v",
+	'Г': "G", 'г': "g", 'Д': "D", 'д': "d", 'Е': "E", 'е': "e",
+	'Ё': "Yo", 'ё': "yo", 'Ж': "Zh", 'ж': "zh", 'З': "Z", 'з': "z",
+	'И': "I", 'и': "i", 'Й': "Y", 'й': "y", 'К': "K", 'к': "k",
+	'Л': "L", 'л': "l", 'М': "M", 'м': "m", 'Н': "N", 'н': "n",
+	'О': "O", 'о': "o", 'П': "P", 'п': "p", 'Р': "R", 'р': "r",
+	'С': "S", 'с': "s", 'Т': "T", 'т': "t", 'У': "U", 'у': "u",
+	'Ф': "F", 'ф': "f", 'Х': "Kh", 'х': "kh", 'Ц': "Ts", 'ц': "ts",
+	'Ч': "Ch", 'ч': "ch", 'Ш': "Sh", 'ш': "sh", 'Щ': "Shch", 'щ': "shch",
+	'Ъ': "", 'ъ': "", 'Ы': "Y", 'ы': "y", 'Ь': "", 'ь': "",
+	'Э': "E", 'э': "e", 'Ю': "Yu", 'ю': "yu", 'Я': "Ya", 'я': "ya",
+	'Є': "Ye", 'є': "ye", 'І': "I", 'і': "i", 'Ї': "Yi", 'ї': "yi",
+	'Ґ': "G", 'ґ': "g", 'Ў': "U", 'ў': "u", 'Ђ': "Dj", 'ђ': "dj",
+	'Ј': "J", 'ј': "j", 'Љ': "Lj", 'љ': "lj", 'Њ': "Nj", 'њ': "nj",
+	'Ћ': "C", 'ћ': "c", 'Џ': "Dz", 'џ': "dz", 'Ѓ': "Gj", 'ѓ': "gj",
+	'Ќ': "Kj", 'ќ': "kj", 'Ѕ': "Dz", 'ѕ': "dz",
+}
+
+// Language-specific transliterations, applied before the built-in tables.
+var asciiLanguages = map[string]map[rune]string{
+	"de": {
+		'Ä': "Ae", 'ä': "ae", 'Ö': "Oe", 'ö': "oe", 'Ü': "Ue", 'ü': "ue",
+	},
+	"da": {
+		'Æ': "Ae", 'æ': "ae", 'Ø': "Oe", 'ø': "oe", 'Å': "Aa", 'å': "aa",
+	},
+	"nb": {
+		'Æ': "Ae", 'æ': "ae", 'Ø': "Oe", 'ø': "oe", 'Å': "Aa", 'å': "aa",
+	},
+	"bg": {
+		'Щ': "Sht", 'щ': "sht", 'Ъ': "A", 'ъ': "a", 'Ь': "Y", 'ь': "y",
+		'Ю': "Yu", 'ю': "yu", 'Я': "Ya", 'я': "ya",
+	},
+	"uk": {
+		'Г': "H", 'г': "h", 'И': "Y", 'и': "y", 'Й': "Y", 'й': "y",
+	},
+	"sr": {
+		'Đ': "Dj", 'đ': "dj",
+	},
+}
+
+// Aliases for language tags sharing a transliteration table.
+var asciiLanguageAliases = map[string]string{
+	"no": "nb",
+	"nn": "nb",
+}
+
+var asciiTable = buildASCIITable()
+
+func buildASCIITable() map[rune]string {
+	table := make(map[rune]string)
+
+	for _, group := range asciiGroups {
+		for _, r := range group[1] {
+			table[r] = group[0]
+		}
+	}
+
+	for r, replacement := range cyrillicLetters {
+		table[r] = replacement
+	}
+
+	return table
+}
+
+// Get the transliterations for a language tag, falling back to its base language.
+func asciiLanguage(lang string) map[rune]string {
+	lang = normalizeLanguage(lang)
+
+	for _, tag := range []string{lang, Before(lang, "-")} {
+		if alias, ok := asciiLanguageAliases[tag]; ok {
+			tag = alias
+		}
+		if table, ok := asciiLanguages[tag]; ok {
+			return table
+		}
+	}
+
+	return nil
+}
+
+// Transliterate a UTF-8 value to ASCII.
+//
+// Characters are looked up in the tables for the given language tag (such as
+// "de" or "da"), then the built-in Latin, Greek, Cyrillic and punctuation
+// tables. Combining marks and characters without a transliteration are
+// removed. Multi-letter transliterations of a letter, such as "ß", are
+// upper-cased when they sit inside an upper-case word.
+func Ascii(value, lang string) string {
+	if IsAscii(value) {
+		return value
+	}
+
+	language := asciiLanguage(lang)
+	runes := []rune(value)
+
+	var builder strings.Builder
+	builder.Grow(len(value))
+
+	for i, r := range runes {
+		if r < utf8.RuneSelf {
+			builder.WriteRune(r)
+			continue
+		}
+
+		replacement, ok := language[r]
+		if !ok {
+			replacement, ok = asciiTable[r]
+		}
+		if !ok {
+			continue
+		}
+
+		if len(replacement) > 1 && unicode.IsLetter(r) && inUpperWord(runes, i) {
+			replacement = Upper(replacement)
+		}
+
+		builder.WriteString(replacement)
+	}
+
+	return builder.String()
+}
+
+// Determine if the letter at index i has an upper-case letter beside it.
+func inUpperWord(runes []rune, i int) bool {
+	if i+1 < len(runes) && unicode.IsLetter(runes[i+1]) {
+		return unicode.IsUpper(runes[i+1])
+	}
+	return i > 0 && unicode.IsUpper(runes[i-1])
+}
+
+// Determine if a given string is 7 bit ASCII.
+func IsAscii(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,59 @@
+package str
+
+import "testing"
+
+func TestAscii(t *testing.T) {
+
+	check := func(value, lang, expected string) {
+		actual := Ascii(value, lang)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("", "", "")
+	check("plain ascii", "", "plain ascii")
+	check("Crème brûlée", "", "Creme brulee")
+	check("Żółta łódka", "", "Zolta lodka")
+	check("Ærøskøbing", "", "Aeroskobing")
+	check("straße", "", "strasse")
+	check("ŠTRAßE", "", "STRASSE")
+	check("GROß Maße", "", "GROSS Masse")
+	check("œuvre ŒUVRE cœur CŒUR", "", "oeuvre OEUVRE coeur COEUR")
+	check("İstanbul ğüşıöç", "", "Istanbul gusioc")
+	check("Tiếng Việt Đà Nẵng", "", "Tieng Viet Da Nang")
+	check("Привет мир", "", "Privet mir")
+	check("Щука ЖУРНАЛ", "", "Shchuka ZHURNAL")
+	check("Αθήνα", "", "Athina")
+	check("Ψυχή", "", "Psychi")
+	check("“quoted” – text…", "", "\"quoted\" - text...")
+	check("été", "", "ete")
+	check("中文 text", "", " text")
+
+	// language-specific overrides
+	check("Müller Öl Äpfel", "de", "Mueller Oel Aepfel")
+	check("Müller", "de-AT", "Mueller")
+	check("Müller", "", "Muller")
+	check("Ærø Åse", "da", "Aeroe Aase")
+	check("blåbær", "no", "blaabaer")
+	check("Щука", "bg", "Shtuka")
+	check("Київ Гаряче", "uk", "Kyyiv Haryache")
+	check("Đorđe", "sr", "Djordje")
+}
+
+func TestIsAscii(t *testing.T) {
+
+	check := func(value string, expected bool) {
+		actual := IsAscii(value)
+		if actual != expected {
+			t.Errorf("Expected <%t> got <%t>", expected, actual)
+		}
+	}
+
+	check("", true)
+	check("Hello, World! 123", true)
+	check("\t\n~", true)
+	check("Crème", false)
+	check("你好", false)
+	check(" ", false)
+}
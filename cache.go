@@ -0,0 +1,125 @@
+package str
+
+import (
+	"container/list"
+	"regexp"
+	"strconv"
+	"sync"
+
+	"github.com/chr15k/go-strings/internal/utils"
+)
+
+// Number of compiled patterns each regexp cache keeps by default.
+const defaultRegexpCacheSize = 256
+
+// A bounded, concurrency-safe cache of compiled regexps, evicting the least
+// recently used pattern when full. Compile errors are cached too.
+type regexpCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type regexpCacheEntry struct {
+	key string
+	re  *regexp.Regexp
+	err error
+}
+
+func newRegexpCache(size int) *regexpCache {
+	return &regexpCache{
+		size:    size,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Get the regexp cached for a key, compiling and caching it on a miss.
+func (c *regexpCache) get(key string, compile func() (*regexp.Regexp, error)) (*regexp.Regexp, error) {
+	c.mu.Lock()
+	if element, ok := c.entries[key]; ok {
+		c.order.MoveToFront(element)
+		entry := element.Value.(*regexpCacheEntry)
+		c.mu.Unlock()
+		return entry.re, entry.err
+	}
+	c.mu.Unlock()
+
+	// compile without holding the lock, so a slow pattern does not block others
+	re, err := compile()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok && c.size > 0 {
+		c.entries[key] = c.order.PushFront(&regexpCacheEntry{key, re, err})
+		c.evict()
+	}
+
+	return re, err
+}
+
+// Change the number of patterns kept, evicting the least recently used ones.
+func (c *regexpCache) resize(size int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.size = size
+	c.evict()
+}
+
+// Get the number of cached patterns.
+func (c *regexpCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *regexpCache) evict() {
+	for c.order.Len() > max(c.size, 0) {
+		element := c.order.Back()
+		c.order.Remove(element)
+		delete(c.entries, element.Value.(*regexpCacheEntry).key)
+	}
+}
+
+var (
+	// Compiled caller-supplied patterns, as given to Match and MatchAll.
+	patternCache = newRegexpCache(defaultRegexpCacheSize)
+
+	// Compiled translations of the wildcard patterns given to Is.
+	wildcardCache = newRegexpCache(defaultRegexpCacheSize)
+)
+
+// Set how many compiled patterns are cached, for regular expressions and for
+// wildcard patterns each. Caching is disabled when size is zero or less.
+func SetRegexpCacheSize(size int) {
+	patternCache.resize(size)
+	wildcardCache.resize(size)
+}
+
+// Compile a regular expression, reusing a cached compilation.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	return patternCache.get(pattern, func() (*regexp.Regexp, error) {
+		return regexp.Compile(pattern)
+	})
+}
+
+// Compile a regular expression, reusing a cached compilation, and panic if it
+// is invalid, as regexp.MustCompile does.
+func mustCompilePattern(pattern string) *regexp.Regexp {
+	re, err := compilePattern(pattern)
+	if err != nil {
+		panic(`regexp: Compile(` + strconv.Quote(pattern) + `): ` + err.Error())
+	}
+	return re
+}
+
+// Compile a wildcard pattern, reusing a cached translation.
+func compileWildcard(pattern string) (*regexp.Regexp, error) {
+	return wildcardCache.get(pattern, func() (*regexp.Regexp, error) {
+		return regexp.Compile(utils.WildCardToRegexp(pattern))
+	})
+}
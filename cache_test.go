@@ -0,0 +1,142 @@
+package str
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+)
+
+func TestRegexpCache(t *testing.T) {
+	cache := newRegexpCache(2)
+	compiles := 0
+
+	get := func(pattern string) (*regexp.Regexp, error) {
+		return cache.get(pattern, func() (*regexp.Regexp, error) {
+			compiles++
+			return regexp.Compile(pattern)
+		})
+	}
+
+	check := func(pattern string, expected int) {
+		if _, err := get(pattern); err != nil {
+			t.Errorf("Expected <%s> to compile got <%s>", pattern, err)
+		}
+		if compiles != expected {
+			t.Errorf("Expected <%d> compilations got <%d> after <%s>", expected, compiles, pattern)
+		}
+	}
+
+	check("a", 1)
+	check("a", 1)
+	check("b", 2)
+	check("a", 2)
+	check("c", 3) // evicts b, the least recently used
+	check("a", 3)
+	check("b", 4)
+
+	if cache.len() != 2 {
+		t.Errorf("Expected <2> cached patterns got <%d>", cache.len())
+	}
+
+	if _, err := get("("); err == nil {
+		t.Errorf("Expected an error for <(>")
+	}
+	if _, err := get("("); err == nil || compiles != 5 {
+		t.Errorf("Expected the error for <(> to be cached")
+	}
+
+	cache.resize(0)
+	check("a", 6)
+	check("a", 7)
+
+	if cache.len() != 0 {
+		t.Errorf("Expected <0> cached patterns got <%d>", cache.len())
+	}
+}
+
+func TestRegexpCacheConcurrency(t *testing.T) {
+	cache := newRegexpCache(8)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				pattern := fmt.Sprintf("^%d-%d$", i%4, j%16)
+				re, err := cache.get(pattern, func() (*regexp.Regexp, error) {
+					return regexp.Compile(pattern)
+				})
+				if err != nil || !re.MatchString(fmt.Sprintf("%d-%d", i%4, j%16)) {
+					t.Errorf("Expected <%s> to match", pattern)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if cache.len() > 8 {
+		t.Errorf("Expected at most <8> cached patterns got <%d>", cache.len())
+	}
+}
+
+func TestSetRegexpCacheSize(t *testing.T) {
+	defer SetRegexpCacheSize(defaultRegexpCacheSize)
+
+	SetRegexpCacheSize(0)
+
+	if Match(`\d+`, "abc123") != "123" || !Is("foo*", "foobar") {
+		t.Errorf("Expected matching to work without a cache")
+	}
+	if patternCache.len() != 0 || wildcardCache.len() != 0 {
+		t.Errorf("Expected no cached patterns")
+	}
+}
+
+func TestExcerptDoesNotCache(t *testing.T) {
+	before := patternCache.len()
+
+	Excerpt("This is my name", "my", 3, "...")
+	Excerpt("This is my name", "a phrase searched only once", 3, "...")
+
+	if patternCache.len() != before {
+		t.Errorf("Expected <%d> cached patterns got <%d>", before, patternCache.len())
+	}
+}
+
+func BenchmarkMatch(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Match(`(\d{4})-(\d{2})-(\d{2})`, "released on 2024-03-15 at noon")
+	}
+}
+
+func BenchmarkMatchUncached(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		regexp.MustCompile(`(\d{4})-(\d{2})-(\d{2})`).FindString("released on 2024-03-15 at noon")
+	}
+}
+
+func BenchmarkIs(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Is([]string{"*.log", "access-*.txt"}, "access-2024.txt")
+	}
+}
+
+func BenchmarkIsUUID(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		IsUUID("a0a2a2d2-0b87-4a18-83f2-2529882be2de")
+	}
+}
+
+func BenchmarkNumbers(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Numbers("(555) 123-4567")
+	}
+}
+
+func BenchmarkFinish(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Finish("this/string//", "/")
+	}
+}
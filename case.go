@@ -0,0 +1,175 @@
+package str
+
+import (
+	"strings"
+
+	"github.com/chr15k/go-strings/internal/utils"
+)
+
+// Case identifies an identifier naming style.
+type Case int
+
+const (
+	CaseUnknown  Case = iota
+	CaseCamel         // camelCase
+	CaseStudly        // StudlyCase
+	CaseSnake         // snake_case
+	CaseKebab         // kebab-case
+	CaseConstant      // CONSTANT_CASE
+	CaseDot           // dot.case
+	CasePath          // path/case
+	CaseTrain         // Train-Case
+	CaseAda           // Ada_Case
+	CaseCobol         // COBOL-CASE
+	CaseSentence      // Sentence case
+)
+
+var caseNames = map[Case]string{
+	CaseUnknown:  "unknown",
+	CaseCamel:    "camel",
+	CaseStudly:   "studly",
+	CaseSnake:    "snake",
+	CaseKebab:    "kebab",
+	CaseConstant: "constant",
+	CaseDot:      "dot",
+	CasePath:     "path",
+	CaseTrain:    "train",
+	CaseAda:      "ada",
+	CaseCobol:    "cobol",
+	CaseSentence: "sentence",
+}
+
+// Order in which DetectCase tries each style, so that ambiguous values
+// (e.g. a single lower-case word) resolve predictably.
+var detectOrder = []Case{
+	CaseCamel,
+	CaseConstant,
+	CaseStudly,
+	CaseSnake,
+	CaseKebab,
+	CaseCobol,
+	CaseTrain,
+	CaseAda,
+	CaseDot,
+	CasePath,
+	CaseSentence,
+}
+
+// Get the name of the case style.
+func (c Case) String() string {
+	if name, ok := caseNames[c]; ok {
+		return name
+	}
+	return caseNames[CaseUnknown]
+}
+
+// Join the words of a value with a separator after applying a transform to each word.
+func joinWords(value, separator string, transform func(string) string) string {
+	words := splitWords(value, nil)
+
+	for i, word := range words {
+		words[i] = transform(word)
+	}
+
+	return strings.Join(words, separator)
+}
+
+// Convert a string to constant case.
+func Constant(value string) string {
+	return joinWords(value, "_", Upper)
+}
+
+// Convert a string to dot case.
+func Dot(value string) string {
+	return joinWords(value, ".", Lower)
+}
+
+// Convert a string to path case.
+func Path(value string) string {
+	return joinWords(value, "/", Lower)
+}
+
+// Convert a string to train case.
+func Train(value string) string {
+	return joinWords(value, "-", func(word string) string {
+		return Ucfirst(Lower(word))
+	})
+}
+
+// Convert a string to Ada case.
+func Ada(value string) string {
+	return joinWords(value, "_", func(word string) string {
+		return Ucfirst(Lower(word))
+	})
+}
+
+// Convert a string to COBOL case.
+func Cobol(value string) string {
+	return joinWords(value, "-", Upper)
+}
+
+// Convert a string to sentence case.
+func Sentence(value string) string {
+	return Ucfirst(joinWords(value, " ", Lower))
+}
+
+// Convert a string to the given case style.
+// An unknown style returns the value unchanged.
+func ConvertCase(value string, c Case) string {
+	return convertCaseWith(value, c, nil)
+}
+
+func convertCaseWith(value string, c Case, overrides Initialisms) string {
+	switch c {
+	case CaseCamel:
+		return CamelWith(value, overrides)
+	case CaseStudly:
+		return StudlyWith(value, overrides)
+	case CaseSnake:
+		return SnakeWith(value, overrides)
+	case CaseKebab:
+		return KebabWith(value, overrides)
+	case CaseConstant:
+		return Constant(value)
+	case CaseDot:
+		return Dot(value)
+	case CasePath:
+		return Path(value)
+	case CaseTrain:
+		return Train(value)
+	case CaseAda:
+		return Ada(value)
+	case CaseCobol:
+		return Cobol(value)
+	case CaseSentence:
+		return Sentence(value)
+	}
+	return value
+}
+
+// Detect the case style of a given string.
+//
+// A value is in a given style if converting it to that style leaves it unchanged,
+// either with or without the registered initialisms, so both "userID" and
+// "userId" are camel case. Values valid in several styles (e.g. "foo" or "FOO")
+// resolve to the first match in the order camel, constant, studly, snake, kebab,
+// COBOL, train, Ada, dot, path and sentence. CaseUnknown is returned when no
+// style matches.
+func DetectCase(value string) Case {
+	if len(value) == 0 {
+		return CaseUnknown
+	}
+
+	plain := Initialisms{}
+	for _, word := range utils.SplitWords(value) {
+		plain[Upper(word)] = false
+	}
+
+	for _, c := range detectOrder {
+		if ConvertCase(value, c) == value || convertCaseWith(value, c, plain) == value {
+			return c
+		}
+	}
+
+	return CaseUnknown
+}
@@ -0,0 +1,173 @@
+package str
+
+import "testing"
+
+func TestConstant(t *testing.T) {
+
+	check := func(value, expected string) {
+		actual := Constant(value)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("foo bar baz", "FOO_BAR_BAZ")
+	check("fooBarBaz", "FOO_BAR_BAZ")
+	check("HTTPServer", "HTTP_SERVER")
+	check("foo-bar.baz", "FOO_BAR_BAZ")
+	check("", "")
+}
+
+func TestDot(t *testing.T) {
+
+	check := func(value, expected string) {
+		actual := Dot(value)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("foo bar baz", "foo.bar.baz")
+	check("FooBarBaz", "foo.bar.baz")
+	check("foo_bar", "foo.bar")
+	check("", "")
+}
+
+func TestPath(t *testing.T) {
+
+	check := func(value, expected string) {
+		actual := Path(value)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("foo bar baz", "foo/bar/baz")
+	check("FooBarBaz", "foo/bar/baz")
+	check("foo.bar", "foo/bar")
+	check("", "")
+}
+
+func TestTrain(t *testing.T) {
+
+	check := func(value, expected string) {
+		actual := Train(value)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("foo bar baz", "Foo-Bar-Baz")
+	check("FOO_BAR", "Foo-Bar")
+	check("httpServer", "Http-Server")
+	check("", "")
+}
+
+func TestAda(t *testing.T) {
+
+	check := func(value, expected string) {
+		actual := Ada(value)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("foo bar baz", "Foo_Bar_Baz")
+	check("FOO-BAR", "Foo_Bar")
+	check("httpServer", "Http_Server")
+	check("", "")
+}
+
+func TestCobol(t *testing.T) {
+
+	check := func(value, expected string) {
+		actual := Cobol(value)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("foo bar baz", "FOO-BAR-BAZ")
+	check("fooBar", "FOO-BAR")
+	check("żółta łódka", "ŻÓŁTA-ŁÓDKA")
+	check("", "")
+}
+
+func TestSentence(t *testing.T) {
+
+	check := func(value, expected string) {
+		actual := Sentence(value)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("foo bar baz", "Foo bar baz")
+	check("fooBarBaz", "Foo bar baz")
+	check("FOO_BAR", "Foo bar")
+	check("", "")
+}
+
+func TestConvertCase(t *testing.T) {
+
+	check := func(value string, c Case, expected string) {
+		actual := ConvertCase(value, c)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("foo_bar", CaseCamel, "fooBar")
+	check("foo_bar", CaseStudly, "FooBar")
+	check("fooBar", CaseSnake, "foo_bar")
+	check("fooBar", CaseKebab, "foo-bar")
+	check("fooBar", CaseConstant, "FOO_BAR")
+	check("fooBar", CaseDot, "foo.bar")
+	check("fooBar", CasePath, "foo/bar")
+	check("fooBar", CaseTrain, "Foo-Bar")
+	check("fooBar", CaseAda, "Foo_Bar")
+	check("fooBar", CaseCobol, "FOO-BAR")
+	check("fooBar", CaseSentence, "Foo bar")
+	check("fooBar", CaseUnknown, "fooBar")
+	check("fooBar", Case(99), "fooBar")
+}
+
+func TestDetectCase(t *testing.T) {
+
+	check := func(value string, expected Case) {
+		actual := DetectCase(value)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("fooBar", CaseCamel)
+	check("userID", CaseCamel)
+	check("userId", CaseCamel)
+	check("HttpServer", CaseStudly)
+	check("foo", CaseCamel)
+	check("FooBar", CaseStudly)
+	check("HTTPServer", CaseStudly)
+	check("foo_bar", CaseSnake)
+	check("foo-bar", CaseKebab)
+	check("FOO_BAR", CaseConstant)
+	check("FOO", CaseConstant)
+	check("foo.bar", CaseDot)
+	check("foo/bar", CasePath)
+	check("Foo-Bar", CaseTrain)
+	check("Foo_Bar", CaseAda)
+	check("FOO-BAR", CaseCobol)
+	check("Foo bar baz", CaseSentence)
+	check("", CaseUnknown)
+	check("foo__bar", CaseUnknown)
+	check("foo_bar-baz", CaseUnknown)
+	check("Foo Bar", CaseUnknown)
+
+	// every style round-trips through detection
+	for _, c := range detectOrder {
+		value := ConvertCase("some identifier name", c)
+		if actual := DetectCase(value); actual != c {
+			t.Errorf("Expected <%s> got <%s> for <%s>", c, actual, value)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+package str
+
+// Get the Levenshtein distance between two strings: the fewest insertions,
+// deletions and substitutions of characters turning one into the other.
+func Levenshtein(a, b string) int {
+	distance, _ := levenshtein([]rune(a), []rune(b), -1)
+	return distance
+}
+
+// Get the Levenshtein distance between two strings if it is at most limit.
+//
+// The computation stops as soon as the distance is known to exceed the limit,
+// in which case limit+1 and false are returned.
+func LevenshteinWithin(a, b string, limit int) (int, bool) {
+	return levenshtein([]rune(a), []rune(b), max(limit, 0))
+}
+
+// Compute the Levenshtein distance with two rows, giving up past a non-negative limit.
+func levenshtein(a, b []rune, limit int) (int, bool) {
+	if limit >= 0 && abs(len(a)-len(b)) > limit {
+		return limit + 1, false
+	}
+
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		lowest := current[0]
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+			lowest = min(lowest, current[j])
+		}
+
+		if limit >= 0 && lowest > limit {
+			return limit + 1, false
+		}
+
+		previous, current = current, previous
+	}
+
+	distance := previous[len(b)]
+	if limit >= 0 && distance > limit {
+		return limit + 1, false
+	}
+
+	return distance, true
+}
+
+// Get the Damerau-Levenshtein distance between two strings, which also counts
+// swapping two adjacent characters as a single edit.
+//
+// This is the unrestricted distance, so "ca" to "abc" takes two edits.
+func DamerauLevenshtein(a, b string) int {
+	distance, _ := damerauLevenshtein([]rune(a), []rune(b), -1)
+	return distance
+}
+
+// Get the Damerau-Levenshtein distance between two strings if it is at most limit.
+//
+// The computation stops as soon as the distance is known to exceed the limit,
+// in which case limit+1 and false are returned.
+func DamerauLevenshteinWithin(a, b string, limit int) (int, bool) {
+	return damerauLevenshtein([]rune(a), []rune(b), max(limit, 0))
+}
+
+// Compute the Damerau-Levenshtein distance (Lowrance-Wagner), giving up past a non-negative limit.
+func damerauLevenshtein(a, b []rune, limit int) (int, bool) {
+	if limit >= 0 && abs(len(a)-len(b)) > limit {
+		return limit + 1, false
+	}
+
+	infinity := len(a) + len(b)
+
+	// d is offset by one row and column holding infinity
+	d := make([][]int, len(a)+2)
+	for i := range d {
+		d[i] = make([]int, len(b)+2)
+		d[i][0] = infinity
+		if i > 0 {
+			d[i][1] = i - 1
+		}
+	}
+	for j := 1; j < len(b)+2; j++ {
+		d[0][j] = infinity
+		d[1][j] = j - 1
+	}
+
+	// row of the last occurrence of each character of a
+	last := make(map[rune]int)
+
+	for i := 1; i <= len(a); i++ {
+		match := 0
+		lowest := d[i+1][1]
+
+		for j := 1; j <= len(b); j++ {
+			k := last[b[j-1]]
+			l := match
+
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+				match = j
+			}
+
+			d[i+1][j+1] = min(
+				d[i][j]+cost,
+				d[i+1][j]+1,
+				d[i][j+1]+1,
+				d[k][l]+(i-k-1)+1+(j-l-1),
+			)
+			lowest = min(lowest, d[i+1][j+1])
+		}
+
+		// no later row can be cheaper than the cheapest cell of this one
+		if limit >= 0 && lowest > limit {
+			return limit + 1, false
+		}
+
+		last[a[i-1]] = i
+	}
+
+	distance := d[len(a)+1][len(b)+1]
+	if limit >= 0 && distance > limit {
+		return limit + 1, false
+	}
+
+	return distance, true
+}
+
+// Get the Hamming distance between two strings of equal length: the number of
+// positions at which their characters differ. Returns -1 when the lengths differ.
+func Hamming(a, b string) int {
+	x, y := []rune(a), []rune(b)
+
+	if len(x) != len(y) {
+		return -1
+	}
+
+	distance := 0
+	for i := range x {
+		if x[i] != y[i] {
+			distance++
+		}
+	}
+
+	return distance
+}
+
+// Get the Jaro similarity of two strings, from 0 for no similarity to 1 for equal strings.
+func Jaro(a, b string) float64 {
+	x, y := []rune(a), []rune(b)
+
+	if len(x) == 0 && len(y) == 0 {
+		return 1
+	}
+	if len(x) == 0 || len(y) == 0 {
+		return 0
+	}
+
+	window := max(max(len(x), len(y))/2-1, 0)
+
+	xMatched := make([]bool, len(x))
+	yMatched := make([]bool, len(y))
+	matches := 0
+
+	for i := range x {
+		from, to := max(0, i-window), min(len(y), i+window+1)
+		for j := from; j < to; j++ {
+			if !yMatched[j] && x[i] == y[j] {
+				xMatched[i], yMatched[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions := 0
+	j := 0
+	for i := range x {
+		if !xMatched[i] {
+			continue
+		}
+		for !yMatched[j] {
+			j++
+		}
+		if x[i] != y[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+
+	return (m/float64(len(x)) + m/float64(len(y)) + (m-float64(transpositions)/2)/m) / 3
+}
+
+// Get the Jaro-Winkler similarity of two strings, from 0 for no similarity to 1 for equal strings.
+//
+// Jaro similarities above 0.7 are boosted for strings sharing a common prefix
+// of up to four characters, with a scaling factor of 0.1.
+func JaroWinkler(a, b string) float64 {
+	similarity := Jaro(a, b)
+
+	if similarity <= 0.7 {
+		return similarity
+	}
+
+	x, y := []rune(a), []rune(b)
+	prefix := 0
+	for prefix < min(4, len(x), len(y)) && x[prefix] == y[prefix] {
+		prefix++
+	}
+
+	return similarity + float64(prefix)*0.1*(1-similarity)
+}
+
+// Get the longest sequence of characters appearing in order, though not
+// necessarily next to each other, in both strings.
+func LongestCommonSubsequence(a, b string) string {
+	x, y := []rune(a), []rune(b)
+
+	// lengths[i][j] is the length of the LCS of x[i:] and y[j:]
+	lengths := make([][]int, len(x)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(y)+1)
+	}
+
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	subsequence := make([]rune, 0, lengths[0][0])
+
+	for i, j := 0, 0; i < len(x) && j < len(y); {
+		switch {
+		case x[i] == y[j]:
+			subsequence = append(subsequence, x[i])
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return string(subsequence)
+}
+
+// Get the similarity of two strings from 0 to 1, based on the Levenshtein
+// distance relative to the length of the longer string.
+func Similarity(a, b string) float64 {
+	longest := max(Length(a), Length(b))
+
+	if longest == 0 {
+		return 1
+	}
+
+	return 1 - float64(Levenshtein(a, b))/float64(longest)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
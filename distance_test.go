@@ -0,0 +1,160 @@
+package str
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+
+	check := func(a, b string, expected int) {
+		actual := Levenshtein(a, b)
+		if actual != expected {
+			t.Errorf("Expected <%d> got <%d> for <%s> <%s>", expected, actual, a, b)
+		}
+		if reverse := Levenshtein(b, a); reverse != expected {
+			t.Errorf("Expected <%d> got <%d> for <%s> <%s>", expected, reverse, b, a)
+		}
+	}
+
+	check("", "", 0)
+	check("abc", "", 3)
+	check("kitten", "sitting", 3)
+	check("flaw", "lawn", 2)
+	check("saturday", "sunday", 3)
+	check("ca", "ac", 2)
+	check("same", "same", 0)
+	check("Łódź", "Lodz", 3)
+	check("日本語", "日本", 1)
+	check("👍🏽", "👍", 1)
+}
+
+func TestLevenshteinWithin(t *testing.T) {
+
+	check := func(a, b string, limit, expected int, ok bool) {
+		actual, within := LevenshteinWithin(a, b, limit)
+		if actual != expected || within != ok {
+			t.Errorf("Expected <%d %t> got <%d %t>", expected, ok, actual, within)
+		}
+	}
+
+	check("kitten", "sitting", 3, 3, true)
+	check("kitten", "sitting", 5, 3, true)
+	check("kitten", "sitting", 2, 3, false)
+	check("kitten", "sitting", 0, 1, false)
+	check("same", "same", 0, 0, true)
+	check("a", "abcdefgh", 3, 4, false)
+	check("abcdefgh", "hgfedcba", 1, 2, false)
+	check("abc", "abd", -1, 1, false)
+}
+
+func TestDamerauLevenshtein(t *testing.T) {
+
+	check := func(a, b string, expected int) {
+		actual := DamerauLevenshtein(a, b)
+		if actual != expected {
+			t.Errorf("Expected <%d> got <%d> for <%s> <%s>", expected, actual, a, b)
+		}
+		if reverse := DamerauLevenshtein(b, a); reverse != expected {
+			t.Errorf("Expected <%d> got <%d> for <%s> <%s>", expected, reverse, b, a)
+		}
+	}
+
+	check("", "", 0)
+	check("abc", "", 3)
+	check("ca", "ac", 1)
+	check("ca", "abc", 2)
+	check("kitten", "sitting", 3)
+	check("teh", "the", 1)
+	check("abcdef", "badcfe", 3)
+	check("żółw", "żłów", 1)
+
+	actual, ok := DamerauLevenshteinWithin("abcdef", "badcfe", 3)
+	if actual != 3 || !ok {
+		t.Errorf("Expected <3 true> got <%d %t>", actual, ok)
+	}
+
+	actual, ok = DamerauLevenshteinWithin("abcdef", "badcfe", 2)
+	if actual != 3 || ok {
+		t.Errorf("Expected <3 false> got <%d %t>", actual, ok)
+	}
+
+	actual, ok = DamerauLevenshteinWithin("ca", "abc", 2)
+	if actual != 2 || !ok {
+		t.Errorf("Expected <2 true> got <%d %t>", actual, ok)
+	}
+}
+
+func TestHamming(t *testing.T) {
+
+	check := func(a, b string, expected int) {
+		actual := Hamming(a, b)
+		if actual != expected {
+			t.Errorf("Expected <%d> got <%d>", expected, actual)
+		}
+	}
+
+	check("", "", 0)
+	check("karolin", "kathrin", 3)
+	check("1011101", "1001001", 2)
+	check("héllo", "hello", 1)
+	check("abc", "ab", -1)
+}
+
+func TestJaroWinkler(t *testing.T) {
+
+	check := func(actual, expected float64) {
+		if math.Abs(actual-expected) > 0.0001 {
+			t.Errorf("Expected <%.4f> got <%.4f>", expected, actual)
+		}
+	}
+
+	check(Jaro("MARTHA", "MARHTA"), 0.9444)
+	check(Jaro("DIXON", "DICKSONX"), 0.7667)
+	check(Jaro("CRATE", "TRACE"), 0.7333)
+	check(Jaro("", ""), 1)
+	check(Jaro("abc", ""), 0)
+	check(Jaro("abc", "xyz"), 0)
+
+	check(JaroWinkler("MARTHA", "MARHTA"), 0.9611)
+	check(JaroWinkler("DIXON", "DICKSONX"), 0.8133)
+	check(JaroWinkler("DWAYNE", "DUANE"), 0.84)
+	check(JaroWinkler("same", "same"), 1)
+	check(JaroWinkler("ärger", "ärgern"), 0.9667)
+	check(JaroWinkler("abc", "xyz"), 0)
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+
+	check := func(a, b, expected string) {
+		actual := LongestCommonSubsequence(a, b)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("", "", "")
+	check("abc", "", "")
+	check("ABCBDAB", "BDCABA", "BDAB")
+	check("AGGTAB", "GXTXAYB", "GTAB")
+	check("same", "same", "same")
+	check("żółty", "żyto", "ży")
+	check("abc", "xyz", "")
+}
+
+func TestSimilarity(t *testing.T) {
+
+	check := func(a, b string, expected float64) {
+		actual := Similarity(a, b)
+		if math.Abs(actual-expected) > 0.0001 {
+			t.Errorf("Expected <%.4f> got <%.4f>", expected, actual)
+		}
+	}
+
+	check("", "", 1)
+	check("abc", "abc", 1)
+	check("abc", "", 0)
+	check("abc", "xyz", 0)
+	check("kitten", "sitting", 1-3.0/7)
+	check("Łódź", "Lodz", 0.25)
+}
@@ -0,0 +1,248 @@
+package str
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Get up to n candidates the input might have been meant as, nearest first.
+//
+// Candidates within a case-insensitive Damerau-Levenshtein distance of a third
+// of the input's length, and at least 2, are suggested, as are candidates
+// starting with the input. Ties are broken by Jaro-Winkler similarity, then
+// by the order of the candidates.
+func Suggest(input string, candidates []string, n int) []string {
+	type suggestion struct {
+		value      string
+		distance   int
+		similarity float64
+	}
+
+	lower := Lower(input)
+	limit := max(Length(input)/3, 2)
+	suggestions := make([]suggestion, 0)
+
+	for _, candidate := range candidates {
+		distance, ok := DamerauLevenshteinWithin(lower, Lower(candidate), limit)
+		if !ok && !(len(lower) > 0 && strings.HasPrefix(Lower(candidate), lower)) {
+			continue
+		}
+		if !ok {
+			distance = DamerauLevenshtein(lower, Lower(candidate))
+		}
+		suggestions = append(suggestions, suggestion{candidate, distance, JaroWinkler(lower, Lower(candidate))})
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].distance != suggestions[j].distance {
+			return suggestions[i].distance < suggestions[j].distance
+		}
+		return suggestions[i].similarity > suggestions[j].similarity
+	})
+
+	values := make([]string, 0, min(max(n, 0), len(suggestions)))
+	for i := 0; i < len(suggestions) && i < n; i++ {
+		values = append(values, suggestions[i].value)
+	}
+
+	return values
+}
+
+// FuzzyResult is a candidate matched by FuzzyFind.
+type FuzzyResult struct {
+	// The matched candidate.
+	Value string
+
+	// Position of the candidate in the slice given to FuzzyFind.
+	Index int
+
+	// Score of the match, higher being better.
+	Score int
+
+	// Indices of the characters of Value matched by the pattern.
+	Positions []int
+}
+
+// Scores used by the fuzzy matcher, modelled on those of fzf.
+const (
+	fuzzyScoreMatch        = 16
+	fuzzyScoreGapStart     = -3
+	fuzzyScoreGapExtension = -1
+
+	// Bonuses for matching a character that starts a word, following white
+	// space, a delimiter, or a lower-case letter or non-digit.
+	fuzzyBonusBoundaryWhite = fuzzyScoreMatch/2 + 2
+	fuzzyBonusBoundary      = fuzzyScoreMatch / 2
+	fuzzyBonusCamel123      = fuzzyBonusBoundary + fuzzyScoreGapExtension
+
+	// Bonus for matching a non-word character, such as the "/" of a path.
+	fuzzyBonusNonWord = fuzzyScoreMatch / 2
+
+	// Minimum bonus of a character matched right after the previous one.
+	fuzzyBonusConsecutive = -(fuzzyScoreGapStart + fuzzyScoreGapExtension)
+
+	// The bonus of the first character of the pattern counts this many times.
+	fuzzyBonusFirstCharMultiplier = 2
+)
+
+// Get the bonus for matching r when it follows prev.
+func fuzzyBonus(prev, r rune) int {
+	switch {
+	case unicode.IsSpace(r):
+		return 0
+	case !isWordRune(r):
+		return fuzzyBonusNonWord
+	case unicode.IsSpace(prev):
+		return fuzzyBonusBoundaryWhite
+	case !isWordRune(prev):
+		return fuzzyBonusBoundary
+	case unicode.IsLower(prev) && unicode.IsUpper(r), !unicode.IsDigit(prev) && unicode.IsDigit(r):
+		return fuzzyBonusCamel123
+	}
+	return 0
+}
+
+// Match a pattern against a value the way fzf does, returning the score of the
+// best alignment and the indices of the characters it matched.
+//
+// Every character of the pattern must appear in the value in order. Matches
+// are rewarded for starting words, for camel case humps and for running
+// consecutively, and penalised for gaps. Matching ignores case unless the
+// pattern contains an upper-case letter.
+func FuzzyMatch(pattern, value string) (int, []int, bool) {
+	needle := []rune(pattern)
+	text := []rune(value)
+
+	if len(needle) == 0 {
+		return 0, []int{}, true
+	}
+
+	if pattern == Lower(pattern) {
+		for i, r := range needle {
+			needle[i] = unicode.ToLower(r)
+		}
+		for i, r := range text {
+			text[i] = unicode.ToLower(r)
+		}
+	}
+
+	// bail out early unless the pattern is a subsequence of the value
+	for i, j := 0, 0; i < len(needle); j++ {
+		if j == len(text) {
+			return 0, nil, false
+		}
+		if needle[i] == text[j] {
+			i++
+		}
+	}
+
+	original := []rune(value)
+	bonuses := make([]int, len(original))
+	for j := range original {
+		prev := ' '
+		if j > 0 {
+			prev = original[j-1]
+		}
+		bonuses[j] = fuzzyBonus(prev, original[j])
+	}
+
+	const none = math.MinInt / 2
+
+	// scores[i][j] is the best score with needle[i] matched at text[j],
+	// from[i][j] the position needle[i-1] was matched at, and chunks[i][j] the
+	// bonus of the first character of the run of consecutive matches ending at j
+	scores := make([][]int, len(needle))
+	from := make([][]int, len(needle))
+	chunks := make([][]int, len(needle))
+
+	for i := range needle {
+		scores[i] = make([]int, len(text))
+		from[i] = make([]int, len(text))
+		chunks[i] = make([]int, len(text))
+
+		gap, gapFrom := none, -1
+
+		for j := range text {
+			if i > 0 && j >= 2 {
+				// best previous match leaving a gap before j
+				gap += fuzzyScoreGapExtension
+				if start := scores[i-1][j-2] + fuzzyScoreGapStart; start >= gap {
+					gap, gapFrom = start, j-2
+				}
+			}
+
+			scores[i][j] = none
+
+			if needle[i] != text[j] {
+				continue
+			}
+
+			if i == 0 {
+				scores[i][j] = fuzzyScoreMatch + bonuses[j]*fuzzyBonusFirstCharMultiplier
+				chunks[i][j] = bonuses[j]
+				continue
+			}
+
+			if j > 0 && scores[i-1][j-1] > none/2 {
+				chunk := chunks[i-1][j-1]
+				if bonuses[j] >= fuzzyBonusBoundary && bonuses[j] > chunk {
+					chunk = bonuses[j]
+				}
+				scores[i][j] = scores[i-1][j-1] + fuzzyScoreMatch + max(bonuses[j], chunk, fuzzyBonusConsecutive)
+				from[i][j] = j - 1
+				chunks[i][j] = chunk
+			}
+
+			if gap > none/2 && gap+fuzzyScoreMatch+bonuses[j] > scores[i][j] {
+				scores[i][j] = gap + fuzzyScoreMatch + bonuses[j]
+				from[i][j] = gapFrom
+				chunks[i][j] = bonuses[j]
+			}
+		}
+	}
+
+	last := len(needle) - 1
+	best := -1
+	for j := range text {
+		if scores[last][j] > none/2 && (best < 0 || scores[last][j] > scores[last][best]) {
+			best = j
+		}
+	}
+
+	if best < 0 {
+		return 0, nil, false
+	}
+
+	positions := make([]int, len(needle))
+	for i, j := last, best; i >= 0; i-- {
+		positions[i] = j
+		j = from[i][j]
+	}
+
+	return scores[last][best], positions, true
+}
+
+// Filter candidates with a fuzzy pattern, best matches first. See FuzzyMatch.
+//
+// Results with equal scores are ordered by the length of the candidate, then
+// by their order in the candidates slice.
+func FuzzyFind(pattern string, candidates []string) []FuzzyResult {
+	results := make([]FuzzyResult, 0)
+
+	for i, candidate := range candidates {
+		if score, positions, ok := FuzzyMatch(pattern, candidate); ok {
+			results = append(results, FuzzyResult{candidate, i, score, positions})
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return Length(results[i].Value) < Length(results[j].Value)
+	})
+
+	return results
+}
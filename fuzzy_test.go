@@ -0,0 +1,109 @@
+package str
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSuggest(t *testing.T) {
+
+	check := func(input string, candidates []string, n int, expected []string) {
+		actual := Suggest(input, candidates, n)
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("Expected <%+v> got <%+v>", expected, actual)
+		}
+	}
+
+	commands := []string{"build", "clean", "commit", "config", "install", "status", "stash"}
+
+	check("comit", commands, 3, []string{"commit"})
+	check("stats", commands, 3, []string{"status", "stash"})
+	check("instal", commands, 3, []string{"install"})
+	check("BUILD", commands, 3, []string{"build"})
+	check("cnofig", commands, 3, []string{"config"})
+	check("co", commands, 3, []string{"commit", "config"})
+	check("xyzzy", commands, 3, []string{})
+	check("stats", commands, 1, []string{"status"})
+	check("stats", commands, 0, []string{})
+	check("stats", nil, 3, []string{})
+	check("zażółć", []string{"zazolc", "zażółw", "żółw"}, 3, []string{"zażółw"})
+}
+
+func TestFuzzyMatch(t *testing.T) {
+
+	check := func(pattern, value string, positions []int, ok bool) {
+		_, actual, matched := FuzzyMatch(pattern, value)
+		if matched != ok || !reflect.DeepEqual(actual, positions) {
+			t.Errorf("Expected <%v %t> got <%v %t>", positions, ok, actual, matched)
+		}
+	}
+
+	check("", "anything", []int{}, true)
+	check("abc", "abc", []int{0, 1, 2}, true)
+	check("abc", "a_b_c", []int{0, 2, 4}, true)
+	check("abc", "acb", nil, false)
+	check("abc", "", nil, false)
+
+	// word starts and camel case humps are preferred over earlier matches
+	check("fb", "foo_bar", []int{0, 4}, true)
+	check("fb", "fabric_bar", []int{0, 7}, true)
+	check("gs", "getString", []int{0, 3}, true)
+	check("sc", "src/main/scanner.go", []int{9, 10}, true)
+
+	// consecutive matches are preferred over scattered ones
+	check("bar", "b_a_r_bar", []int{6, 7, 8}, true)
+
+	// smart case
+	check("abc", "ABC", []int{0, 1, 2}, true)
+	check("Abc", "abc", nil, false)
+	check("Abc", "xAbc", []int{1, 2, 3}, true)
+
+	// multibyte
+	check("żł", "żółw", []int{0, 2}, true)
+	check("日語", "日本語", []int{0, 2}, true)
+}
+
+func TestFuzzyScore(t *testing.T) {
+
+	better := func(pattern, a, b string) {
+		x, _, _ := FuzzyMatch(pattern, a)
+		y, _, _ := FuzzyMatch(pattern, b)
+		if x <= y {
+			t.Errorf("Expected <%s> (%d) to score above <%s> (%d) for <%s>", a, x, b, y, pattern)
+		}
+	}
+
+	better("abc", "abc", "a_b_c")
+	better("abc", "abcdef", "xabcdef")
+	better("fb", "foo bar", "fxxxb")
+	better("ss", "SomeString", "lesson")
+	better("main", "src/main.go", "src/domain.go")
+}
+
+func TestFuzzyFind(t *testing.T) {
+
+	candidates := []string{"internal/utils/utils.go", "str.go", "stringable.go", "str_test.go", "README.md"}
+
+	results := FuzzyFind("strgo", candidates)
+	values := make([]string, 0, len(results))
+	for _, result := range results {
+		values = append(values, result.Value)
+	}
+
+	expected := []string{"str.go", "str_test.go", "stringable.go"}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("Expected <%+v> got <%+v>", expected, values)
+	}
+
+	if results[0].Index != 1 || !reflect.DeepEqual(results[0].Positions, []int{0, 1, 2, 4, 5}) {
+		t.Errorf("Expected <1 [0 1 2 4 5]> got <%d %v>", results[0].Index, results[0].Positions)
+	}
+
+	if all := FuzzyFind("", candidates); len(all) != len(candidates) {
+		t.Errorf("Expected <%d> got <%d>", len(candidates), len(all))
+	}
+
+	if none := FuzzyFind("zzz", candidates); len(none) != 0 {
+		t.Errorf("Expected no results got <%+v>", none)
+	}
+}
@@ -0,0 +1,289 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Grapheme_Cluster_Break property values from UAX #29.
+const (
+	gcbOther = iota
+	gcbCR
+	gcbLF
+	gcbControl
+	gcbExtend
+	gcbZWJ
+	gcbRegionalIndicator
+	gcbPrepend
+	gcbSpacingMark
+	gcbL
+	gcbV
+	gcbT
+	gcbLV
+	gcbLVT
+)
+
+// Characters with Grapheme_Cluster_Break=Prepend.
+var gcbPrependTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0600, Hi: 0x0605, Stride: 1},
+		{Lo: 0x06DD, Hi: 0x06DD, Stride: 1},
+		{Lo: 0x070F, Hi: 0x070F, Stride: 1},
+		{Lo: 0x0890, Hi: 0x0891, Stride: 1},
+		{Lo: 0x08E2, Hi: 0x08E2, Stride: 1},
+		{Lo: 0x0D4E, Hi: 0x0D4E, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x110BD, Hi: 0x110BD, Stride: 1},
+		{Lo: 0x110CD, Hi: 0x110CD, Stride: 1},
+		{Lo: 0x111C2, Hi: 0x111C3, Stride: 1},
+		{Lo: 0x11A3A, Hi: 0x11A3A, Stride: 1},
+		{Lo: 0x11A84, Hi: 0x11A89, Stride: 1},
+		{Lo: 0x11D46, Hi: 0x11D46, Stride: 1},
+	},
+}
+
+// An approximation of Extended_Pictographic covering the emoji blocks and the
+// pictographic symbols that may start an emoji sequence.
+var extendedPictographicTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00A9, Hi: 0x00AE, Stride: 5},
+		{Lo: 0x203C, Hi: 0x203C, Stride: 1},
+		{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+		{Lo: 0x2122, Hi: 0x2122, Stride: 1},
+		{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+		{Lo: 0x2194, Hi: 0x2199, Stride: 1},
+		{Lo: 0x21A9, Hi: 0x21AA, Stride: 1},
+		{Lo: 0x231A, Hi: 0x231B, Stride: 1},
+		{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+		{Lo: 0x2388, Hi: 0x2388, Stride: 1},
+		{Lo: 0x23CF, Hi: 0x23CF, Stride: 1},
+		{Lo: 0x23E9, Hi: 0x23F3, Stride: 1},
+		{Lo: 0x23F8, Hi: 0x23FA, Stride: 1},
+		{Lo: 0x24C2, Hi: 0x24C2, Stride: 1},
+		{Lo: 0x25AA, Hi: 0x25AB, Stride: 1},
+		{Lo: 0x25B6, Hi: 0x25B6, Stride: 1},
+		{Lo: 0x25C0, Hi: 0x25C0, Stride: 1},
+		{Lo: 0x25FB, Hi: 0x25FE, Stride: 1},
+		{Lo: 0x2600, Hi: 0x27BF, Stride: 1},
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+		{Lo: 0x2B05, Hi: 0x2B07, Stride: 1},
+		{Lo: 0x2B1B, Hi: 0x2B1C, Stride: 1},
+		{Lo: 0x2B50, Hi: 0x2B50, Stride: 1},
+		{Lo: 0x2B55, Hi: 0x2B55, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+		{Lo: 0x303D, Hi: 0x303D, Stride: 1},
+		{Lo: 0x3297, Hi: 0x3299, Stride: 2},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1F000, Hi: 0x1F0FF, Stride: 1},
+		{Lo: 0x1F10D, Hi: 0x1F10F, Stride: 1},
+		{Lo: 0x1F12F, Hi: 0x1F12F, Stride: 1},
+		{Lo: 0x1F16C, Hi: 0x1F171, Stride: 1},
+		{Lo: 0x1F17E, Hi: 0x1F17F, Stride: 1},
+		{Lo: 0x1F18E, Hi: 0x1F18E, Stride: 1},
+		{Lo: 0x1F191, Hi: 0x1F19A, Stride: 1},
+		{Lo: 0x1F1AD, Hi: 0x1F1E5, Stride: 1},
+		{Lo: 0x1F201, Hi: 0x1F20F, Stride: 1},
+		{Lo: 0x1F21A, Hi: 0x1F21A, Stride: 1},
+		{Lo: 0x1F22F, Hi: 0x1F22F, Stride: 1},
+		{Lo: 0x1F232, Hi: 0x1F23A, Stride: 1},
+		{Lo: 0x1F23C, Hi: 0x1F23F, Stride: 1},
+		{Lo: 0x1F249, Hi: 0x1F3FA, Stride: 1},
+		{Lo: 0x1F400, Hi: 0x1F53D, Stride: 1},
+		{Lo: 0x1F546, Hi: 0x1F64F, Stride: 1},
+		{Lo: 0x1F680, Hi: 0x1F6FF, Stride: 1},
+		{Lo: 0x1F774, Hi: 0x1F77F, Stride: 1},
+		{Lo: 0x1F7D5, Hi: 0x1F7FF, Stride: 1},
+		{Lo: 0x1F80C, Hi: 0x1F80F, Stride: 1},
+		{Lo: 0x1F848, Hi: 0x1F84F, Stride: 1},
+		{Lo: 0x1F85A, Hi: 0x1F85F, Stride: 1},
+		{Lo: 0x1F888, Hi: 0x1F88F, Stride: 1},
+		{Lo: 0x1F8AE, Hi: 0x1F8FF, Stride: 1},
+		{Lo: 0x1F90C, Hi: 0x1F93A, Stride: 1},
+		{Lo: 0x1F93C, Hi: 0x1F945, Stride: 1},
+		{Lo: 0x1F947, Hi: 0x1FAFF, Stride: 1},
+		{Lo: 0x1FC00, Hi: 0x1FFFD, Stride: 1},
+	},
+}
+
+// Get the Grapheme_Cluster_Break property of a rune.
+func graphemeBreak(r rune) int {
+	switch {
+	case r == '\r':
+		return gcbCR
+	case r == '\n':
+		return gcbLF
+	case r == 0x200D:
+		return gcbZWJ
+	case r == 0x200C,
+		r >= 0x1F3FB && r <= 0x1F3FF, // emoji modifiers
+		r >= 0xE0020 && r <= 0xE007F, // tags
+		r == 0xFF9E || r == 0xFF9F:
+		return gcbExtend
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return gcbRegionalIndicator
+	case r >= 0x1100 && r <= 0x115F, r >= 0xA960 && r <= 0xA97C:
+		return gcbL
+	case r >= 0x1160 && r <= 0x11A7, r >= 0xD7B0 && r <= 0xD7C6:
+		return gcbV
+	case r >= 0x11A8 && r <= 0x11FF, r >= 0xD7CB && r <= 0xD7FB:
+		return gcbT
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return gcbLV
+		}
+		return gcbLVT
+	case unicode.Is(gcbPrependTable, r):
+		return gcbPrepend
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		return gcbExtend
+	case unicode.Is(unicode.Mc, r):
+		return gcbSpacingMark
+	case unicode.In(r, unicode.Cc, unicode.Zl, unicode.Zp),
+		unicode.Is(unicode.Cf, r) && r != 0x200C && r != 0x200D:
+		return gcbControl
+	}
+	return gcbOther
+}
+
+// Determine if there is a grapheme cluster boundary between prev and next.
+//
+// emoji reports whether the cluster so far matches ExtPict Extend* ZWJ, and
+// ri the number of regional indicators directly preceding next.
+func graphemeBoundary(prev, next int, emoji bool, ri int) bool {
+	switch {
+	case prev == gcbCR && next == gcbLF: // GB3
+		return false
+	case prev == gcbCR || prev == gcbLF || prev == gcbControl: // GB4
+		return true
+	case next == gcbCR || next == gcbLF || next == gcbControl: // GB5
+		return true
+	case prev == gcbL && (next == gcbL || next == gcbV || next == gcbLV || next == gcbLVT): // GB6
+		return false
+	case (prev == gcbLV || prev == gcbV) && (next == gcbV || next == gcbT): // GB7
+		return false
+	case (prev == gcbLVT || prev == gcbT) && next == gcbT: // GB8
+		return false
+	case next == gcbExtend || next == gcbZWJ: // GB9
+		return false
+	case next == gcbSpacingMark: // GB9a
+		return false
+	case prev == gcbPrepend: // GB9b
+		return false
+	case prev == gcbRegionalIndicator && next == gcbRegionalIndicator: // GB12, GB13
+		return ri%2 == 0
+	}
+	return !emoji // GB11, GB999
+}
+
+// Split a string into user-perceived characters (extended grapheme clusters).
+//
+// The segmentation follows the UAX #29 rules, using the stdlib Unicode tables
+// for marks and controls and an approximation of Extended_Pictographic for
+// emoji sequences. The Indic conjunct rule (GB9c) is not applied.
+func Graphemes(value string) []string {
+	clusters := make([]string, 0, len(value))
+
+	start := 0
+	prev := gcbOther
+	pictographic := false // current cluster matches ExtPict Extend*
+	emoji := false        // current cluster matches ExtPict Extend* ZWJ
+	ri := 0
+
+	for i, r := range value {
+		next := graphemeBreak(r)
+
+		if i > 0 {
+			if graphemeBoundary(prev, next, emoji && unicode.Is(extendedPictographicTable, r), ri) {
+				clusters = append(clusters, value[start:i])
+				start = i
+				pictographic = false
+			}
+		}
+
+		switch {
+		case unicode.Is(extendedPictographicTable, r):
+			pictographic = true
+			emoji = false
+		case next == gcbExtend && pictographic:
+			emoji = false
+		case next == gcbZWJ && pictographic:
+			emoji = true
+			pictographic = false
+		default:
+			pictographic = false
+			emoji = false
+		}
+
+		if next == gcbRegionalIndicator {
+			ri++
+		} else {
+			ri = 0
+		}
+
+		prev = next
+	}
+
+	if start < len(value) {
+		clusters = append(clusters, value[start:])
+	}
+
+	return clusters
+}
+
+// Get the number of user-perceived characters in a given string.
+func GraphemeLength(value string) int {
+	if IsAscii(value) && !strings.Contains(value, "\r\n") {
+		return len(value)
+	}
+	return len(Graphemes(value))
+}
+
+// Get the portion of a string specified by the start and length parameters,
+// counted in user-perceived characters. See Substr.
+func GraphemeSubstr(value string, start, length int) string {
+	clusters := Graphemes(value)
+
+	from, to, ok := substrRange(len(clusters), start, length)
+	if !ok {
+		return ""
+	}
+
+	return strings.Join(clusters[from:to], "")
+}
+
+// Take the first or last {limit} user-perceived characters of a string.
+func GraphemeTake(value string, limit int) string {
+	if limit < 0 {
+		return GraphemeSubstr(value, limit, GraphemeLength(value))
+	}
+	return GraphemeSubstr(value, 0, limit)
+}
+
+// Limit the number of user-perceived characters in a string.
+func GraphemeLimit(value string, limit int) string {
+	if GraphemeLength(value) <= limit || limit <= 0 {
+		return value
+	}
+	return GraphemeSubstr(value, 0, limit) + "..."
+}
+
+// Masks a portion of a string with a repeated character, counted in user-perceived characters.
+func GraphemeMask(value, character string, index, length int) string {
+	return mask(value, character, index, length, GraphemeSubstr, GraphemeLength)
+}
+
+// Reverse the user-perceived characters of a given string, keeping each cluster intact.
+func GraphemeReverse(value string) string {
+	clusters := Graphemes(value)
+
+	var builder strings.Builder
+	builder.Grow(len(value))
+
+	for i := len(clusters) - 1; i >= 0; i-- {
+		builder.WriteString(clusters[i])
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,136 @@
+package str
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGraphemes(t *testing.T) {
+
+	check := func(value string, expected []string) {
+		actual := Graphemes(value)
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("Expected <%+q> got <%+q>", expected, actual)
+		}
+	}
+
+	check("", []string{})
+	check("abc", []string{"a", "b", "c"})
+	check("a\r\nb", []string{"a", "\r\n", "b"})
+	check("e\u0301te\u0301", []string{"e\u0301", "t", "e\u0301"})
+	check("👍🏽!", []string{"👍🏽", "!"})
+	check("🇬🇧🇫🇷", []string{"🇬🇧", "🇫🇷"})
+	check("🇬🇧🇫", []string{"🇬🇧", "🇫"})
+	check("👨‍👩‍👧‍👦x", []string{"👨‍👩‍👧‍👦", "x"})
+	check("🏳️‍🌈", []string{"🏳️‍🌈"})
+	check("a\u200db", []string{"a\u200d", "b"})
+	check("한국어", []string{"한", "국", "어"})
+	check("각", []string{"각"})
+	check("नमस्ते", []string{"न", "म", "स्", "ते"})
+	check("؀١", []string{"؀١"})
+}
+
+func TestGraphemeLength(t *testing.T) {
+
+	check := func(value string, expected int) {
+		actual := GraphemeLength(value)
+		if actual != expected {
+			t.Errorf("Expected <%d> got <%d>", expected, actual)
+		}
+	}
+
+	check("", 0)
+	check("chris@example.com", 17)
+	check("a\r\nb", 3)
+	check("👍🏽", 1)
+	check("🇬🇧", 1)
+	check("Jo\u0308nko\u0308ping", 9)
+	check("Je\u0301ro\u0302me", 6)
+	check("这是一段中文", 6)
+}
+
+func TestGraphemeSubstr(t *testing.T) {
+
+	check := func(value string, start, length int, expected string) {
+		actual := GraphemeSubstr(value, start, length)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("abcdef", 1, 3, "bcd")
+	check("abcdef", -2, 2, "ef")
+	check("abcdef", 0, -1, "abcde")
+	check("abcdef", 10, 1, "")
+	check("", 0, 1, "")
+	check("🇬🇧🇫🇷🇩🇪", 1, 1, "🇫🇷")
+	check("ne\u0301e", 1, 1, "e\u0301")
+	check("👍🏽👍🏿", -1, 1, "👍🏿")
+}
+
+func TestGraphemeTake(t *testing.T) {
+
+	check := func(value string, limit int, expected string) {
+		actual := GraphemeTake(value, limit)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("abcdef", 2, "ab")
+	check("abcdef", -2, "ef")
+	check("abcdef", 0, "")
+	check("👍🏽👍🏿", 1, "👍🏽")
+	check("👍🏽👍🏿", -1, "👍🏿")
+}
+
+func TestGraphemeLimit(t *testing.T) {
+
+	check := func(value string, limit int, expected string) {
+		actual := GraphemeLimit(value, limit)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("chris", 3, "chr...")
+	check("chris", 0, "chris")
+	check("chris", 10, "chris")
+	check("Cafe\u0301 noir", 4, "Cafe\u0301...")
+	check("🇬🇧🇫🇷🇩🇪", 2, "🇬🇧🇫🇷...")
+}
+
+func TestGraphemeMask(t *testing.T) {
+
+	check := func(value, character string, index, length int, expected string) {
+		actual := GraphemeMask(value, character, index, length)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("chris@example.com", "*", 3, 8, "chr********le.com")
+	check("chris@example.com", "*", -6, 3, "chris@examp***com")
+	check("abcdef", "*", 0, -1, "*****f")
+	check("Jéróme", "*", 1, 3, "J***me")
+	check("Je\u0301ro\u0301me", "*", 1, 3, "J***me")
+	check("🇬🇧🇫🇷🇩🇪", "*", 1, 1, "🇬🇧*🇩🇪")
+	check("abc", "👍🏽", 0, 2, "👍🏽👍🏽c")
+	check("abc", "", 0, 2, "abc")
+}
+
+func TestGraphemeReverse(t *testing.T) {
+
+	check := func(value, expected string) {
+		actual := GraphemeReverse(value)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("", "")
+	check("abc", "cba")
+	check("ne\u0301e", "ee\u0301n")
+	check("🇬🇧🇫🇷", "🇫🇷🇬🇧")
+	check("a👍🏽b", "b👍🏽a")
+}
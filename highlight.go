@@ -0,0 +1,135 @@
+package str
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// HighlightOptions configures HighlightWith.
+type HighlightOptions struct {
+	// Match Latin letters regardless of accents, so "cafe" matches "Café".
+	IgnoreAccents bool
+
+	// Only match terms standing as whole words.
+	WholeWords bool
+
+	// HTML-escape the text so it is safe to render, leaving before and after as given.
+	EscapeHTML bool
+}
+
+// Text folded for matching, with the span of the original text each folded rune came from.
+type foldedText struct {
+	runes  []rune
+	starts []int
+	ends   []int
+}
+
+// Fold a value to lower-case, and to unaccented Latin letters when ignoreAccents is set.
+func foldText(value string, ignoreAccents bool) foldedText {
+	folded := foldedText{}
+
+	for i, r := range value {
+		end := i + utf8.RuneLen(r)
+
+		if ignoreAccents && unicode.Is(unicode.Mn, r) {
+			// let the letter the mark belongs to cover it
+			if len(folded.ends) > 0 {
+				folded.ends[len(folded.ends)-1] = end
+			}
+			continue
+		}
+
+		replacement := string(r)
+		if ignoreAccents && (r < 0x0370 || r >= 0x1E00 && r <= 0x1EFF) {
+			if ascii, ok := asciiTable[r]; ok {
+				replacement = ascii
+			}
+		}
+
+		for _, f := range replacement {
+			folded.runes = append(folded.runes, unicode.ToLower(f))
+			folded.starts = append(folded.starts, i)
+			folded.ends = append(folded.ends, end)
+		}
+	}
+
+	return folded
+}
+
+// Determine if a rune continues a word.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.In(r, unicode.Mn, unicode.Mc)
+}
+
+// Wrap every case-insensitive occurrence of any of the terms in a string. See HighlightWith.
+func Highlight(value string, terms []string, before, after string) string {
+	return HighlightWith(value, terms, before, after, HighlightOptions{})
+}
+
+// Wrap every case-insensitive occurrence of any of the terms in a string with
+// the given strings, using the given options.
+//
+// As with Wrap, after defaults to before when empty. Overlapping and adjacent
+// matches are merged and wrapped once.
+func HighlightWith(value string, terms []string, before, after string, opts HighlightOptions) string {
+	text := foldText(value, opts.IgnoreAccents)
+	matches := make([][2]int, 0)
+
+	for _, term := range terms {
+		needle := foldText(term, opts.IgnoreAccents).runes
+		if len(needle) == 0 {
+			continue
+		}
+
+		for i := 0; i+len(needle) <= len(text.runes); i++ {
+			if string(text.runes[i:i+len(needle)]) != string(needle) {
+				continue
+			}
+
+			start, end := text.starts[i], text.ends[i+len(needle)-1]
+
+			if opts.WholeWords {
+				previous, _ := utf8.DecodeLastRuneInString(value[:start])
+				next, _ := utf8.DecodeRuneInString(value[end:])
+				if start > 0 && isWordRune(previous) || end < len(value) && isWordRune(next) {
+					continue
+				}
+			}
+
+			matches = append(matches, [2]int{start, end})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i][0] < matches[j][0]
+	})
+
+	escape := func(s string) string {
+		if opts.EscapeHTML {
+			return html.EscapeString(s)
+		}
+		return s
+	}
+
+	var builder strings.Builder
+	last := 0
+
+	for i := 0; i < len(matches); {
+		start, end := matches[i][0], matches[i][1]
+
+		for i++; i < len(matches) && matches[i][0] <= end; i++ {
+			end = max(end, matches[i][1])
+		}
+
+		builder.WriteString(escape(value[last:start]))
+		builder.WriteString(Wrap(escape(value[start:end]), before, after))
+		last = end
+	}
+
+	builder.WriteString(escape(value[last:]))
+
+	return builder.String()
+}
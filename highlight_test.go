@@ -0,0 +1,65 @@
+package str
+
+import "testing"
+
+func TestHighlight(t *testing.T) {
+
+	check := func(value string, terms []string, before, after, expected string) {
+		actual := Highlight(value, terms, before, after)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("The quick brown fox", []string{"quick", "fox"}, "<b>", "</b>", "The <b>quick</b> brown <b>fox</b>")
+	check("The Quick brown FOX", []string{"quick", "fox"}, "*", "", "The *Quick* brown *FOX*")
+	check("foo foo foo", []string{"foo"}, "[", "]", "[foo] [foo] [foo]")
+	check("The quick brown fox", []string{"cat"}, "<b>", "</b>", "The quick brown fox")
+	check("The quick brown fox", []string{}, "<b>", "</b>", "The quick brown fox")
+	check("The quick brown fox", []string{""}, "<b>", "</b>", "The quick brown fox")
+	check("", []string{"fox"}, "<b>", "</b>", "")
+
+	// overlapping and adjacent matches are merged
+	check("abcdef", []string{"abc", "bcd"}, "[", "]", "[abcd]ef")
+	check("abcdef", []string{"abc", "def"}, "[", "]", "[abcdef]")
+	check("banana", []string{"ana"}, "[", "]", "b[anana]")
+	check("quickly quick", []string{"quick", "quickly"}, "[", "]", "[quickly] [quick]")
+
+	// multibyte
+	check("Zażółć gęślą jaźń", []string{"GĘŚLĄ"}, "[", "]", "Zażółć [gęślą] jaźń")
+	check("Straße", []string{"STRASSE"}, "[", "]", "Straße")
+	check("你好世界", []string{"世界"}, "[", "]", "你好[世界]")
+}
+
+func TestHighlightWith(t *testing.T) {
+
+	check := func(value string, terms []string, opts HighlightOptions, expected string) {
+		actual := HighlightWith(value, terms, "<mark>", "</mark>", opts)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	// accents
+	accents := HighlightOptions{IgnoreAccents: true}
+	check("Café crème", []string{"cafe", "CREME"}, accents, "<mark>Café</mark> <mark>crème</mark>")
+	check("Cafe creme", []string{"café"}, accents, "<mark>Cafe</mark> creme")
+	check("Café noir", []string{"cafe"}, accents, "<mark>Café</mark> noir")
+	check("Straße", []string{"strasse"}, accents, "<mark>Straße</mark>")
+	check("Café crème", []string{"cafe"}, HighlightOptions{}, "Café crème")
+
+	// whole words
+	words := HighlightOptions{WholeWords: true}
+	check("cat concat cats cat.", []string{"cat"}, words, "<mark>cat</mark> concat cats <mark>cat</mark>.")
+	check("über überall", []string{"über"}, words, "<mark>über</mark> überall")
+	check("cat concat", []string{"cat"}, HighlightOptions{}, "<mark>cat</mark> con<mark>cat</mark>")
+
+	// escaping
+	escape := HighlightOptions{EscapeHTML: true}
+	check("<p>Tom & Jerry</p>", []string{"tom"}, escape, "&lt;p&gt;<mark>Tom</mark> &amp; Jerry&lt;/p&gt;")
+	check("a <b> c", []string{"<b>"}, escape, "a <mark>&lt;b&gt;</mark> c")
+	check("<p>Tom & Jerry</p>", []string{"tom"}, HighlightOptions{}, "<p><mark>Tom</mark> & Jerry</p>")
+
+	check("Crème brûlée, <b>crème</b>", []string{"creme"}, HighlightOptions{IgnoreAccents: true, WholeWords: true, EscapeHTML: true},
+		"<mark>Crème</mark> brûlée, &lt;b&gt;<mark>crème</mark>&lt;/b&gt;")
+}
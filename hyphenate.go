@@ -0,0 +1,237 @@
+package str
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The soft hyphen, an invisible break opportunity shown as "-" when a line is broken there.
+const SoftHyphen = "\u00ad"
+
+// Hyphenator finds the points where words may be hyphenated using Liang's
+// algorithm, the one used by TeX.
+//
+// Patterns are letter sequences interleaved with digits, such as "hy3ph" or
+// ".ach4", where "." marks the start or end of a word. Odd digits allow a
+// hyphen between the surrounding letters and even digits forbid one, the
+// highest digit from any matching pattern winning.
+type Hyphenator struct {
+	patterns   map[string][]int
+	maxLength  int
+	exceptions map[string][]int
+	leftMin    int
+	rightMin   int
+}
+
+// Create a Hyphenator from whitespace separated TeX patterns and exceptions.
+//
+// Exceptions are whole words with hyphens marking every allowed break, such as
+// "ta-ble", and take precedence over the patterns. No hyphen is placed before
+// the first leftMin or after the last rightMin letters of a word.
+func NewHyphenator(patterns, exceptions string, leftMin, rightMin int) *Hyphenator {
+	h := &Hyphenator{
+		patterns:   make(map[string][]int),
+		exceptions: make(map[string][]int),
+		leftMin:    max(leftMin, 1),
+		rightMin:   max(rightMin, 1),
+	}
+
+	for _, pattern := range strings.Fields(patterns) {
+		letters := make([]rune, 0, len(pattern))
+		values := []int{0}
+
+		for _, r := range pattern {
+			if r >= '0' && r <= '9' {
+				values[len(values)-1] = int(r - '0')
+				continue
+			}
+			letters = append(letters, unicode.ToLower(r))
+			values = append(values, 0)
+		}
+
+		h.patterns[string(letters)] = values
+		h.maxLength = max(h.maxLength, len(letters))
+	}
+
+	for _, exception := range strings.Fields(exceptions) {
+		points := make([]int, 0)
+		letters := 0
+
+		for _, r := range exception {
+			if r == '-' {
+				points = append(points, letters)
+				continue
+			}
+			letters++
+		}
+
+		h.exceptions[Lower(strings.ReplaceAll(exception, "-", ""))] = points
+	}
+
+	return h
+}
+
+// Get the positions, counted in characters, at which a single word may be hyphenated.
+func (h *Hyphenator) Points(word string) []int {
+	lower := Lower(word)
+	size := utf8.RuneCountInString(lower)
+
+	if points, ok := h.exceptions[lower]; ok {
+		return append([]int(nil), points...)
+	}
+
+	if size < h.leftMin+h.rightMin {
+		return nil
+	}
+
+	letters := []rune("." + lower + ".")
+	values := make([]int, len(letters)+1)
+
+	for i := range letters {
+		for j := i + 1; j <= len(letters) && j-i <= h.maxLength; j++ {
+			pattern, ok := h.patterns[string(letters[i:j])]
+			if !ok {
+				continue
+			}
+			for k, value := range pattern {
+				values[i+k] = max(values[i+k], value)
+			}
+		}
+	}
+
+	points := make([]int, 0)
+	for i := h.leftMin; i <= size-h.rightMin; i++ {
+		// values[i+1] sits between letters[i] and letters[i+1], which are
+		// the word's characters i-1 and i
+		if values[i+1]%2 == 1 {
+			points = append(points, i)
+		}
+	}
+
+	return points
+}
+
+// Get the byte offsets in a string at which a hyphen may be inserted.
+func (h *Hyphenator) breaks(value string) []int {
+	offsets := make([]int, 0)
+	start := -1
+
+	hyphenate := func(end int) {
+		word := value[start:end]
+		for _, point := range h.Points(word) {
+			offsets = append(offsets, start+len(string([]rune(word)[:point])))
+		}
+		start = -1
+	}
+
+	for i, r := range value {
+		if isLetter(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			hyphenate(i)
+		}
+	}
+
+	if start >= 0 {
+		hyphenate(len(value))
+	}
+
+	return offsets
+}
+
+// Determine if a rune is part of a word for hyphenation.
+func isLetter(r rune) bool {
+	return unicode.IsLetter(r) || unicode.In(r, unicode.Mn, unicode.Mc)
+}
+
+// Insert a marker at every point where the words of a string may be
+// hyphenated. The marker defaults to a soft hyphen when empty.
+func (h *Hyphenator) Hyphenate(value, marker string) string {
+	if len(marker) == 0 {
+		marker = SoftHyphen
+	}
+
+	var builder strings.Builder
+	last := 0
+
+	for _, offset := range h.breaks(value) {
+		builder.WriteString(value[last:offset])
+		builder.WriteString(marker)
+		last = offset
+	}
+
+	builder.WriteString(value[last:])
+
+	return builder.String()
+}
+
+// Split a string at the last hyphenation point that leaves the head, with a
+// trailing "-", within the given display width.
+func (h *Hyphenator) split(value string, width int) (string, string, bool) {
+	offsets := h.breaks(value)
+
+	for i := len(offsets) - 1; i >= 0; i-- {
+		if Width(value[:offsets[i]])+1 <= width {
+			return value[:offsets[i]] + "-", value[offsets[i]:], true
+		}
+	}
+
+	return "", value, false
+}
+
+var (
+	hyphenatorsMu sync.RWMutex
+
+	// Built-in patterns keyed by lower-case language tag.
+	hyphenators = map[string]*Hyphenator{
+		"en": englishHyphenator,
+	}
+)
+
+// Register the hyphenator used for a language tag, such as "de" or "en-GB",
+// replacing any hyphenator already registered for it.
+func RegisterHyphenator(lang string, h *Hyphenator) {
+	hyphenatorsMu.Lock()
+	defer hyphenatorsMu.Unlock()
+
+	hyphenators[normalizeLanguage(lang)] = h
+}
+
+// Get the hyphenator registered for a language tag.
+//
+// A regional tag such as "en-GB" falls back to its base language "en", and an
+// unregistered language falls back to English.
+func HyphenatorFor(lang string) *Hyphenator {
+	hyphenatorsMu.RLock()
+	defer hyphenatorsMu.RUnlock()
+
+	lang = normalizeLanguage(lang)
+
+	if h, ok := hyphenators[lang]; ok {
+		return h
+	}
+
+	if h, ok := hyphenators[Before(lang, "-")]; ok {
+		return h
+	}
+
+	return englishHyphenator
+}
+
+// Insert a marker at every point where the English words of a string may be
+// hyphenated. The marker defaults to a soft hyphen when empty.
+func Hyphenate(value, marker string) string {
+	return englishHyphenator.Hyphenate(value, marker)
+}
+
+// Insert a marker at every point where the words of a string in the given
+// language may be hyphenated. The marker defaults to a soft hyphen when empty.
+func HyphenateIn(lang, value, marker string) string {
+	return HyphenatorFor(lang).Hyphenate(value, marker)
+}
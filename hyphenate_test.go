@@ -0,0 +1,69 @@
+package str
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHyphenate(t *testing.T) {
+
+	check := func(value, marker, expected string) {
+		actual := Hyphenate(value, marker)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("hyphenation", "-", "hy-phen-ation")
+	check("internationalization", "-", "in-ter-na-tion-al-iza-tion")
+	check("supercalifragilisticexpialidocious", "-", "su-per-cal-ifrag-ilis-tic-ex-pi-ali-do-cious")
+	check("The encyclopedia of typography", "-", "The en-cy-clo-pe-dia of ty-pog-ra-phy")
+	check("Responsibility, characteristics!", "-", "Re-spon-si-bil-ity, char-ac-ter-is-tics!")
+	check("algorithm", "", "al\u00adgo\u00adrithm")
+	check("", "-", "")
+
+	// exceptions
+	check("table", "-", "ta-ble")
+	check("project", "-", "project")
+	check("Associate", "-", "As-so-ciate")
+
+	// short words are never hyphenated
+	check("a to the", "-", "a to the")
+}
+
+func TestHyphenatorPoints(t *testing.T) {
+
+	check := func(word string, expected []int) {
+		actual := HyphenatorFor("en").Points(word)
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("Expected <%v> got <%v>", expected, actual)
+		}
+	}
+
+	check("hyphenation", []int{2, 6})
+	check("programming", []int{3, 7})
+	check("computer", []int{3})
+	check("ox", nil)
+}
+
+func TestHyphenateIn(t *testing.T) {
+
+	check := func(lang, value, expected string) {
+		actual := HyphenateIn(lang, value, "-")
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	// a made-up language with a handful of patterns
+	RegisterHyphenator("xx", NewHyphenator("1ba 1ca a1a", "ab-ab", 1, 1))
+
+	check("xx", "abaca", "a-ba-ca")
+	check("xx", "aaa", "a-a-a")
+	check("xx", "abab", "ab-ab")
+	check("xx-YY", "abaca", "a-ba-ca")
+
+	// English is the fallback
+	check("en-US", "hyphenation", "hy-phen-ation")
+	check("zz", "hyphenation", "hy-phen-ation")
+}
@@ -0,0 +1,262 @@
+package str
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/chr15k/go-strings/internal/utils"
+)
+
+type inflectionRule struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+// Inflector holds the rules used to pluralize and singularize words.
+//
+// Rules are regular expressions matched case-insensitively against the whole
+// word; the most recently added matching rule wins, so registered rules
+// override the built-in ones. Irregular words and uncountable words are
+// checked before any rule.
+type Inflector struct {
+	mu                 sync.RWMutex
+	plurals            []inflectionRule
+	singulars          []inflectionRule
+	irregularPlurals   map[string]string
+	irregularSingulars map[string]string
+	uncountables       map[string]bool
+}
+
+// Create a new Inflector with no rules.
+func NewInflector() *Inflector {
+	return &Inflector{
+		irregularPlurals:   make(map[string]string),
+		irregularSingulars: make(map[string]string),
+		uncountables:       make(map[string]bool),
+	}
+}
+
+// Add a rule used to pluralize words matching the pattern.
+// The replacement may refer to capture groups, e.g. "${1}ves".
+func (in *Inflector) AddPluralRule(pattern, replacement string) {
+	rule := inflectionRule{regexp.MustCompile("(?i)" + pattern), replacement}
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	in.plurals = append(in.plurals, rule)
+}
+
+// Add a rule used to singularize words matching the pattern.
+// The replacement may refer to capture groups, e.g. "${1}y".
+func (in *Inflector) AddSingularRule(pattern, replacement string) {
+	rule := inflectionRule{regexp.MustCompile("(?i)" + pattern), replacement}
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	in.singulars = append(in.singulars, rule)
+}
+
+// Add an irregular singular and plural pair.
+func (in *Inflector) AddIrregular(singular, plural string) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	in.irregularPlurals[Lower(singular)] = Lower(plural)
+	in.irregularSingulars[Lower(plural)] = Lower(singular)
+}
+
+// Add words that have no distinct plural form.
+func (in *Inflector) AddUncountable(words ...string) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	for _, word := range words {
+		in.uncountables[Lower(word)] = true
+	}
+}
+
+// Get the plural form of a word, or the word itself when count is 1 or -1.
+func (in *Inflector) Plural(word string, count int) string {
+	if count == 1 || count == -1 {
+		return word
+	}
+
+	return in.inflect(word, in.irregularPlurals, in.irregularSingulars, func() []inflectionRule {
+		return in.plurals
+	})
+}
+
+// Get the singular form of a word.
+func (in *Inflector) Singular(word string) string {
+	return in.inflect(word, in.irregularSingulars, in.irregularPlurals, func() []inflectionRule {
+		return in.singulars
+	})
+}
+
+// Inflect a word using the irregular forms, then the rules in reverse order.
+// Words already in the target irregular form are returned unchanged.
+func (in *Inflector) inflect(word string, irregular, inverse map[string]string, rules func() []inflectionRule) string {
+	if len(strings.TrimSpace(word)) == 0 {
+		return word
+	}
+
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	lower := Lower(word)
+
+	if in.uncountables[lower] {
+		return word
+	}
+
+	if inflected, ok := irregular[lower]; ok {
+		return matchCase(word, inflected)
+	}
+
+	if _, ok := inverse[lower]; ok {
+		return word
+	}
+
+	list := rules()
+	for i := len(list) - 1; i >= 0; i-- {
+		if list[i].pattern.MatchString(word) {
+			return matchCase(word, list[i].pattern.ReplaceAllString(word, list[i].replacement))
+		}
+	}
+
+	return word
+}
+
+// Apply the casing of the original word to its inflected form.
+func matchCase(original, inflected string) string {
+	if Length(original) > 1 && original == Upper(original) && original != Lower(original) {
+		return Upper(inflected)
+	}
+
+	if Substr(original, 0, 1) == Upper(Substr(original, 0, 1)) {
+		return Ucfirst(inflected)
+	}
+
+	return inflected
+}
+
+// Pluralize the last word of a studly or camel cased string, keeping any
+// text after it. An initialism takes a lower-case suffix, as in "UserIDs",
+// unless the whole string is upper-case.
+func (in *Inflector) PluralStudly(value string, count int) string {
+	words := utils.SplitWords(value)
+
+	if len(words) == 0 {
+		return value
+	}
+
+	last := words[len(words)-1]
+	position := strings.LastIndex(value, last)
+	plural := in.Plural(last, count)
+
+	if value != Upper(value) && Length(last) > 1 && last == Upper(last) && strings.HasPrefix(plural, last) {
+		plural = last + Lower(plural[len(last):])
+	}
+
+	return value[:position] + plural + value[position+len(last):]
+}
+
+var (
+	inflectorsMu sync.RWMutex
+
+	// Built-in rule sets keyed by lower-case language tag.
+	inflectors = map[string]*Inflector{
+		"en": english,
+		"es": spanish,
+		"fr": french,
+		"de": german,
+		"pt": portuguese,
+	}
+)
+
+// Register the inflector used for a language tag, such as "nl" or "pt-BR",
+// replacing any inflector already registered for it.
+func RegisterInflector(lang string, in *Inflector) {
+	inflectorsMu.Lock()
+	defer inflectorsMu.Unlock()
+
+	inflectors[normalizeLanguage(lang)] = in
+}
+
+// Get the inflector registered for a language tag.
+//
+// A regional tag such as "pt-BR" falls back to its base language "pt", and an
+// unregistered language falls back to English.
+func InflectorFor(lang string) *Inflector {
+	inflectorsMu.RLock()
+	defer inflectorsMu.RUnlock()
+
+	lang = normalizeLanguage(lang)
+
+	if in, ok := inflectors[lang]; ok {
+		return in
+	}
+
+	if in, ok := inflectors[Before(lang, "-")]; ok {
+		return in
+	}
+
+	return english
+}
+
+func normalizeLanguage(lang string) string {
+	return Lower(strings.ReplaceAll(strings.TrimSpace(lang), "_", "-"))
+}
+
+// Get the plural form of a word in the given language, or the word itself when count is 1 or -1.
+func PluralIn(lang, word string, count int) string {
+	return InflectorFor(lang).Plural(word, count)
+}
+
+// Get the singular form of a word in the given language.
+func SingularIn(lang, word string) string {
+	return InflectorFor(lang).Singular(word)
+}
+
+// Pluralize the last word of a studly or camel cased string in the given language.
+func PluralStudlyIn(lang, value string, count int) string {
+	return InflectorFor(lang).PluralStudly(value, count)
+}
+
+// Get the plural form of an English word, or the word itself when count is 1 or -1.
+func Plural(word string, count int) string {
+	return english.Plural(word, count)
+}
+
+// Get the singular form of an English word.
+func Singular(word string) string {
+	return english.Singular(word)
+}
+
+// Pluralize the last English word of a studly or camel cased string.
+func PluralStudly(value string, count int) string {
+	return english.PluralStudly(value, count)
+}
+
+// Add a rule used to pluralize English words matching the pattern.
+func AddPluralRule(pattern, replacement string) {
+	english.AddPluralRule(pattern, replacement)
+}
+
+// Add a rule used to singularize English words matching the pattern.
+func AddSingularRule(pattern, replacement string) {
+	english.AddSingularRule(pattern, replacement)
+}
+
+// Add an irregular English singular and plural pair.
+func AddIrregular(singular, plural string) {
+	english.AddIrregular(singular, plural)
+}
+
+// Add English words that have no distinct plural form.
+func AddUncountable(words ...string) {
+	english.AddUncountable(words...)
+}
@@ -0,0 +1,79 @@
+package str
+
+// German inflection rules, in order of increasing precedence.
+//
+// German plurals are largely unpredictable, so the rules cover the regular
+// suffixes and the most common umlaut and -e plurals are registered as
+// irregulars.
+var german = newGermanInflector()
+
+func newGermanInflector() *Inflector {
+	in := NewInflector()
+
+	in.AddPluralRule(`$`, "e")
+	in.AddPluralRule(`e$`, "en")
+	in.AddPluralRule(`(el|er|en|chen|lein)$`, "${1}")
+	in.AddPluralRule(`([aiouy])$`, "${1}s")
+	in.AddPluralRule(`([^aeiouäöü][aiouy]s)$`, "${1}")
+	in.AddPluralRule(`(nis)$`, "${1}se")
+	in.AddPluralRule(`um$`, "en")
+	in.AddPluralRule(`(in)$`, "${1}nen")
+	in.AddPluralRule(`(ung|heit|keit|schaft|ion|tät|ik)$`, "${1}en")
+	in.AddPluralRule(`(nisse)$`, "${1}")
+	in.AddPluralRule(`(ling|ment|jekt|dukt|flikt|takt|trakt)e$`, "${1}e")
+
+	in.AddSingularRule(`(e)n$`, "${1}")
+	in.AddSingularRule(`([aiouy])s$`, "${1}")
+	in.AddSingularRule(`(nis)se$`, "${1}")
+	in.AddSingularRule(`(in)nen$`, "${1}")
+	in.AddSingularRule(`(ung|heit|keit|schaft|ion|tät|ik)en$`, "${1}")
+	in.AddSingularRule(`(ling|ment|jekt|dukt|flikt|takt|trakt)e$`, "${1}")
+
+	in.AddIrregular("abend", "abende")
+	in.AddIrregular("apfel", "äpfel")
+	in.AddIrregular("baum", "bäume")
+	in.AddIrregular("berg", "berge")
+	in.AddIrregular("brief", "briefe")
+	in.AddIrregular("bruder", "brüder")
+	in.AddIrregular("buch", "bücher")
+	in.AddIrregular("bus", "busse")
+	in.AddIrregular("film", "filme")
+	in.AddIrregular("fisch", "fische")
+	in.AddIrregular("format", "formate")
+	in.AddIrregular("frau", "frauen")
+	in.AddIrregular("freund", "freunde")
+	in.AddIrregular("fuß", "füße")
+	in.AddIrregular("gymnasium", "gymnasien")
+	in.AddIrregular("hand", "hände")
+	in.AddIrregular("haus", "häuser")
+	in.AddIrregular("hund", "hunde")
+	in.AddIrregular("jahr", "jahre")
+	in.AddIrregular("kind", "kinder")
+	in.AddIrregular("land", "länder")
+	in.AddIrregular("mann", "männer")
+	in.AddIrregular("maus", "mäuse")
+	in.AddIrregular("monat", "monate")
+	in.AddIrregular("museum", "museen")
+	in.AddIrregular("mutter", "mütter")
+	in.AddIrregular("nacht", "nächte")
+	in.AddIrregular("preis", "preise")
+	in.AddIrregular("problem", "probleme")
+	in.AddIrregular("schritt", "schritte")
+	in.AddIrregular("schuh", "schuhe")
+	in.AddIrregular("spiel", "spiele")
+	in.AddIrregular("stadt", "städte")
+	in.AddIrregular("studium", "studien")
+	in.AddIrregular("system", "systeme")
+	in.AddIrregular("tag", "tage")
+	in.AddIrregular("termin", "termine")
+	in.AddIrregular("tier", "tiere")
+	in.AddIrregular("tisch", "tische")
+	in.AddIrregular("vater", "väter")
+	in.AddIrregular("weg", "wege")
+	in.AddIrregular("wort", "wörter")
+	in.AddIrregular("zentrum", "zentren")
+
+	in.AddUncountable("daten", "information", "leute", "obst", "wetter")
+
+	return in
+}
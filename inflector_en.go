@@ -0,0 +1,81 @@
+package str
+
+// English inflection rules, in order of increasing precedence.
+var english = newEnglishInflector()
+
+func newEnglishInflector() *Inflector {
+	in := NewInflector()
+
+	in.AddPluralRule(`$`, "s")
+	in.AddPluralRule(`s$`, "s")
+	in.AddPluralRule(`^(ax|test)is$`, "${1}es")
+	in.AddPluralRule(`(octop|vir)us$`, "${1}i")
+	in.AddPluralRule(`(octop|vir)i$`, "${1}i")
+	in.AddPluralRule(`(alias|status|campus)$`, "${1}es")
+	in.AddPluralRule(`(bu)s$`, "${1}ses")
+	in.AddPluralRule(`(buffal|tomat|potat|her|ech)o$`, "${1}oes")
+	in.AddPluralRule(`([ti])um$`, "${1}a")
+	in.AddPluralRule(`([ti])a$`, "${1}a")
+	in.AddPluralRule(`sis$`, "ses")
+	in.AddPluralRule(`(?:([^f])fe|([lr])f)$`, "${1}${2}ves")
+	in.AddPluralRule(`(hive)$`, "${1}s")
+	in.AddPluralRule(`([^aeiouy]|qu)y$`, "${1}ies")
+	in.AddPluralRule(`(x|ch|ss|sh|zz)$`, "${1}es")
+	in.AddPluralRule(`(matr|vert|ind)(?:ix|ex)$`, "${1}ices")
+	in.AddPluralRule(`^(m|l)ouse$`, "${1}ice")
+	in.AddPluralRule(`^(m|l)ice$`, "${1}ice")
+	in.AddPluralRule(`^(ox)$`, "${1}en")
+	in.AddPluralRule(`^(oxen)$`, "${1}")
+	in.AddPluralRule(`(quiz)$`, "${1}zes")
+
+	in.AddSingularRule(`s$`, "")
+	in.AddSingularRule(`(ss)$`, "${1}")
+	in.AddSingularRule(`(n)ews$`, "${1}ews")
+	in.AddSingularRule(`([ti])a$`, "${1}um")
+	in.AddSingularRule(`((a)naly|(b)a|(d)iagno|(p)arenthe|(p)rogno|(s)ynop|(t)he)(sis|ses)$`, "${1}sis")
+	in.AddSingularRule(`(^analy)(sis|ses)$`, "${1}sis")
+	in.AddSingularRule(`([^f])ves$`, "${1}fe")
+	in.AddSingularRule(`(hive)s$`, "${1}")
+	in.AddSingularRule(`(tive)s$`, "${1}")
+	in.AddSingularRule(`([lr])ves$`, "${1}f")
+	in.AddSingularRule(`([^aeiouy]|qu)ies$`, "${1}y")
+	in.AddSingularRule(`(s)eries$`, "${1}eries")
+	in.AddSingularRule(`(m)ovies$`, "${1}ovie")
+	in.AddSingularRule(`(x|ch|ss|sh|zz)es$`, "${1}")
+	in.AddSingularRule(`^(m|l)ice$`, "${1}ouse")
+	in.AddSingularRule(`(bus|campus)(es)?$`, "${1}")
+	in.AddSingularRule(`(o)es$`, "${1}")
+	in.AddSingularRule(`(shoe)s$`, "${1}")
+	in.AddSingularRule(`(cris|test)(is|es)$`, "${1}is")
+	in.AddSingularRule(`^(a)x[ie]s$`, "${1}xis")
+	in.AddSingularRule(`(octop|vir)(us|i)$`, "${1}us")
+	in.AddSingularRule(`(alias|status)(es)?$`, "${1}")
+	in.AddSingularRule(`^(ox)en`, "${1}")
+	in.AddSingularRule(`(vert|ind)ices$`, "${1}ex")
+	in.AddSingularRule(`(matr)ices$`, "${1}ix")
+	in.AddSingularRule(`(quiz)zes$`, "${1}")
+	in.AddSingularRule(`(database)s$`, "${1}")
+
+	in.AddIrregular("child", "children")
+	in.AddIrregular("criterion", "criteria")
+	in.AddIrregular("foot", "feet")
+	in.AddIrregular("goose", "geese")
+	in.AddIrregular("man", "men")
+	in.AddIrregular("move", "moves")
+	in.AddIrregular("person", "people")
+	in.AddIrregular("sex", "sexes")
+	in.AddIrregular("tooth", "teeth")
+	in.AddIrregular("woman", "women")
+	in.AddIrregular("zombie", "zombies")
+
+	in.AddUncountable(
+		"audio", "bison", "cattle", "chassis", "compensation", "data", "deer",
+		"education", "emoji", "equipment", "evidence", "feedback", "firmware",
+		"fish", "furniture", "gold", "hardware", "information", "jedi", "kin",
+		"knowledge", "love", "metadata", "money", "moose", "news", "nutrition",
+		"offspring", "plankton", "pokemon", "police", "rain", "rice", "series",
+		"sheep", "software", "species", "swine", "traffic", "wheat",
+	)
+
+	return in
+}
@@ -0,0 +1,45 @@
+package str
+
+// Spanish inflection rules, in order of increasing precedence.
+var spanish = newSpanishInflector()
+
+func newSpanishInflector() *Inflector {
+	in := NewInflector()
+
+	in.AddPluralRule(`$`, "es")
+	in.AddPluralRule(`([aeiouáéó])$`, "${1}s")
+	in.AddPluralRule(`([aeiou]s)$`, "${1}")
+	in.AddPluralRule(`z$`, "ces")
+	in.AddPluralRule(`és$`, "eses")
+	in.AddPluralRule(`ús$`, "uses")
+	in.AddPluralRule(`án$`, "anes")
+	in.AddPluralRule(`ín$`, "ines")
+	in.AddPluralRule(`ón$`, "ones")
+
+	in.AddSingularRule(`s$`, "")
+	in.AddSingularRule(`([aeiouáéíóú][lrndjy])es$`, "${1}")
+	in.AddSingularRule(`ses$`, "se")
+	in.AddSingularRule(`ces$`, "z")
+	in.AddSingularRule(`eses$`, "és")
+	in.AddSingularRule(`uses$`, "ús")
+	in.AddSingularRule(`ones$`, "ón")
+	in.AddSingularRule(`iones$`, "ión")
+
+	in.AddIrregular("carácter", "caracteres")
+	in.AddIrregular("clon", "clones")
+	in.AddIrregular("dios", "dioses")
+	in.AddIrregular("don", "dones")
+	in.AddIrregular("dron", "drones")
+	in.AddIrregular("joven", "jóvenes")
+	in.AddIrregular("mes", "meses")
+	in.AddIrregular("régimen", "regímenes")
+	in.AddIrregular("ron", "rones")
+	in.AddIrregular("son", "sones")
+
+	in.AddUncountable(
+		"análisis", "crisis", "cumpleaños", "gafas", "jueves", "lunes", "martes",
+		"miércoles", "paraguas", "tesis", "viernes", "virus",
+	)
+
+	return in
+}
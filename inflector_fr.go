@@ -0,0 +1,40 @@
+package str
+
+// French inflection rules, in order of increasing precedence.
+var french = newFrenchInflector()
+
+func newFrenchInflector() *Inflector {
+	in := NewInflector()
+
+	in.AddPluralRule(`$`, "s")
+	in.AddPluralRule(`(s|x|z)$`, "${1}")
+	in.AddPluralRule(`(eau|au|eu)$`, "${1}x")
+	in.AddPluralRule(`al$`, "aux")
+	in.AddPluralRule(`(bij|caill|ch|gen|hib|jouj|p)ou$`, "${1}oux")
+	in.AddPluralRule(`(b|cor|ém|soupir|trav|vant|vitr)ail$`, "${1}aux")
+
+	in.AddSingularRule(`s$`, "")
+	in.AddSingularRule(`aux$`, "al")
+	in.AddSingularRule(`(eau|eu)x$`, "${1}")
+	in.AddSingularRule(`(bij|caill|ch|gen|hib|jouj|p)oux$`, "${1}ou")
+	in.AddSingularRule(`(b|cor|ém|soupir|trav|vant|vitr)aux$`, "${1}ail")
+
+	in.AddIrregular("bal", "bals")
+	in.AddIrregular("bleu", "bleus")
+	in.AddIrregular("carnaval", "carnavals")
+	in.AddIrregular("ciel", "cieux")
+	in.AddIrregular("festival", "festivals")
+	in.AddIrregular("madame", "mesdames")
+	in.AddIrregular("mademoiselle", "mesdemoiselles")
+	in.AddIrregular("monsieur", "messieurs")
+	in.AddIrregular("noyau", "noyaux")
+	in.AddIrregular("pneu", "pneus")
+	in.AddIrregular("tuyau", "tuyaux")
+	in.AddIrregular("œil", "yeux")
+
+	in.AddUncountable(
+		"avis", "bois", "bras", "corps", "fois", "nez", "pays", "prix", "souris", "temps",
+	)
+
+	return in
+}
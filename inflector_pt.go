@@ -0,0 +1,54 @@
+package str
+
+// Portuguese inflection rules, in order of increasing precedence.
+var portuguese = newPortugueseInflector()
+
+func newPortugueseInflector() *Inflector {
+	in := NewInflector()
+
+	in.AddPluralRule(`$`, "s")
+	in.AddPluralRule(`s$`, "s")
+	in.AddPluralRule(`(r|z)$`, "${1}es")
+	in.AddPluralRule(`ão$`, "ões")
+	in.AddPluralRule(`al$`, "ais")
+	in.AddPluralRule(`el$`, "éis")
+	in.AddPluralRule(`ol$`, "óis")
+	in.AddPluralRule(`ul$`, "uis")
+	in.AddPluralRule(`il$`, "is")
+	in.AddPluralRule(`m$`, "ns")
+	in.AddPluralRule(`ês$`, "eses")
+
+	in.AddSingularRule(`s$`, "")
+	in.AddSingularRule(`(r|z)es$`, "${1}")
+	in.AddSingularRule(`ões$`, "ão")
+	in.AddSingularRule(`^([^áéíóúâêôãõ]*)is$`, "${1}il")
+	in.AddSingularRule(`ais$`, "al")
+	in.AddSingularRule(`éis$`, "el")
+	in.AddSingularRule(`óis$`, "ol")
+	in.AddSingularRule(`uis$`, "ul")
+	in.AddSingularRule(`ns$`, "m")
+	in.AddSingularRule(`^([^áéíóúâêôãõ]*)eses$`, "${1}ês")
+
+	// -ão words with -ães plurals; other -ães words are regular, e.g. "mães"
+	in.AddIrregular("alemão", "alemães")
+	in.AddIrregular("cão", "cães")
+	in.AddIrregular("capitão", "capitães")
+	in.AddIrregular("cônsul", "cônsules")
+	in.AddIrregular("gás", "gases")
+	in.AddIrregular("mal", "males")
+	in.AddIrregular("mão", "mãos")
+	in.AddIrregular("país", "países")
+	in.AddIrregular("pão", "pães")
+	in.AddIrregular("tese", "teses")
+
+	// -i words whose plurals would otherwise be read as -il plurals; words
+	// with an accent, such as "táxis" and "júris", never are
+	in.AddIrregular("abacaxi", "abacaxis")
+	in.AddIrregular("bisturi", "bisturis")
+	in.AddIrregular("javali", "javalis")
+	in.AddIrregular("rubi", "rubis")
+
+	in.AddUncountable("lápis", "ônibus", "tênis", "tórax", "vírus")
+
+	return in
+}
@@ -0,0 +1,381 @@
+package str
+
+import "testing"
+
+func TestPlural(t *testing.T) {
+
+	check := func(word string, count int, expected string) {
+		actual := Plural(word, count)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("user", 2, "users")
+	check("user", 1, "user")
+	check("user", -1, "user")
+	check("user", 0, "users")
+	check("users", 2, "users")
+	check("box", 2, "boxes")
+	check("church", 2, "churches")
+	check("city", 2, "cities")
+	check("day", 2, "days")
+	check("knife", 2, "knives")
+	check("wolf", 2, "wolves")
+	check("analysis", 2, "analyses")
+	check("status", 2, "statuses")
+	check("bus", 2, "buses")
+	check("potato", 2, "potatoes")
+	check("matrix", 2, "matrices")
+	check("index", 2, "indices")
+	check("medium", 2, "media")
+	check("mouse", 2, "mice")
+	check("quiz", 2, "quizzes")
+	check("ox", 2, "oxen")
+
+	// irregular and uncountable words
+	check("child", 2, "children")
+	check("person", 2, "people")
+	check("people", 2, "people")
+	check("sheep", 2, "sheep")
+	check("news", 2, "news")
+
+	// case preservation
+	check("Child", 2, "Children")
+	check("CHILD", 2, "CHILDREN")
+	check("Test", 2, "Tests")
+	check("BOX", 2, "BOXES")
+
+	check("", 2, "")
+}
+
+func TestSingular(t *testing.T) {
+
+	check := func(word, expected string) {
+		actual := Singular(word)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("users", "user")
+	check("user", "user")
+	check("boxes", "box")
+	check("churches", "church")
+	check("cities", "city")
+	check("knives", "knife")
+	check("wolves", "wolf")
+	check("analyses", "analysis")
+	check("statuses", "status")
+	check("status", "status")
+	check("buses", "bus")
+	check("potatoes", "potato")
+	check("matrices", "matrix")
+	check("media", "medium")
+	check("mice", "mouse")
+	check("quizzes", "quiz")
+	check("movies", "movie")
+	check("databases", "database")
+	check("class", "class")
+	check("children", "child")
+	check("child", "child")
+	check("People", "Person")
+	check("SHEEP", "SHEEP")
+	check("", "")
+}
+
+func TestPluralStudly(t *testing.T) {
+
+	check := func(value string, count int, expected string) {
+		actual := PluralStudly(value, count)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("VerifiedHuman", 2, "VerifiedHumans")
+	check("UserFeedback", 2, "UserFeedback")
+	check("SomeChild", 2, "SomeChildren")
+	check("ProductCategory", 2, "ProductCategories")
+	check("ProductCategory", 1, "ProductCategory")
+	check("blogPost", 2, "blogPosts")
+	check("UserID", 2, "UserIDs")
+	check("UserIDs", 2, "UserIDs")
+	check("ListAPI", 2, "ListAPIs")
+	check("USER_ID", 2, "USER_IDS")
+	check("user_profile_", 2, "user_profiles_")
+	check("user profile!", 2, "user profiles!")
+	check("", 2, "")
+}
+
+func TestInflectorRegistration(t *testing.T) {
+
+	in := NewInflector()
+	in.AddPluralRule(`$`, "s")
+	in.AddSingularRule(`s$`, "")
+
+	check := func(actual, expected string) {
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check(in.Plural("cactus", 2), "cactuss")
+
+	in.AddPluralRule(`(cact)us$`, "${1}i")
+	in.AddSingularRule(`(cact)i$`, "${1}us")
+	in.AddIrregular("octopus", "octopodes")
+	in.AddUncountable("aircraft")
+
+	check(in.Plural("cactus", 2), "cacti")
+	check(in.Singular("Cacti"), "Cactus")
+	check(in.Plural("octopus", 2), "octopodes")
+	check(in.Singular("octopodes"), "octopus")
+	check(in.Plural("aircraft", 2), "aircraft")
+	check(in.Plural("dog", 2), "dogs")
+
+	// the package level functions register against the English inflector,
+	// swapped for a fresh one so the test leaves the global untouched
+	saved := english
+	english = newEnglishInflector()
+	t.Cleanup(func() { english = saved })
+
+	check(Plural("ganglion", 2), "ganglions")
+	AddIrregular("ganglion", "ganglia")
+	check(Plural("ganglion", 2), "ganglia")
+	check(Singular("ganglia"), "ganglion")
+
+	AddUncountable("gravel")
+	check(Plural("gravel", 2), "gravel")
+
+	AddPluralRule(`(phenomen)on$`, "${1}a")
+	AddSingularRule(`(phenomen)a$`, "${1}on")
+	check(Plural("phenomenon", 2), "phenomena")
+	check(Singular("phenomena"), "phenomenon")
+}
+
+func TestPluralIn(t *testing.T) {
+
+	check := func(lang, word string, count int, expected string) {
+		actual := PluralIn(lang, word, count)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("en", "child", 2, "children")
+
+	check("es", "casa", 2, "casas")
+	check("es", "papel", 2, "papeles")
+	check("es", "luz", 2, "luces")
+	check("es", "canción", 2, "canciones")
+	check("es", "autobús", 2, "autobuses")
+	check("es", "inglés", 2, "ingleses")
+	check("es", "lunes", 2, "lunes")
+	check("es", "Mes", 2, "Meses")
+	check("es", "casa", 1, "casa")
+
+	check("fr", "maison", 2, "maisons")
+	check("fr", "bateau", 2, "bateaux")
+	check("fr", "cheval", 2, "chevaux")
+	check("fr", "bijou", 2, "bijoux")
+	check("fr", "travail", 2, "travaux")
+	check("fr", "festival", 2, "festivals")
+	check("fr", "prix", 2, "prix")
+	check("fr", "œil", 2, "yeux")
+
+	check("de", "Hund", 2, "Hunde")
+	check("de", "Blume", 2, "Blumen")
+	check("de", "Lehrer", 2, "Lehrer")
+	check("de", "Lehrerin", 2, "Lehrerinnen")
+	check("de", "Zeitung", 2, "Zeitungen")
+	check("de", "Auto", 2, "Autos")
+	check("de", "Ergebnis", 2, "Ergebnisse")
+	check("de", "Museum", 2, "Museen")
+	check("de", "Mann", 2, "Männer")
+	check("de", "Hunde", 2, "Hunde")
+	check("de", "Museen", 2, "Museen")
+	check("de", "Zeitungen", 2, "Zeitungen")
+	check("de", "Lehrerinnen", 2, "Lehrerinnen")
+	check("de", "Ergebnisse", 2, "Ergebnisse")
+	check("de", "Zentrum", 2, "Zentren")
+	check("de", "Autos", 2, "Autos")
+	check("de", "Handys", 2, "Handys")
+	check("de", "Preis", 2, "Preise")
+	check("de", "Bus", 2, "Busse")
+	check("de", "Tisch", 2, "Tische")
+	check("de", "Tische", 2, "Tische")
+	check("de", "Dokument", 2, "Dokumente")
+	check("de", "Dokumente", 2, "Dokumente")
+	check("de", "Projekte", 2, "Projekte")
+	check("de", "Lehrling", 2, "Lehrlinge")
+	check("de", "Termin", 2, "Termine")
+
+	check("pt", "carro", 2, "carros")
+	check("pt", "flor", 2, "flores")
+	check("pt", "canção", 2, "canções")
+	check("pt", "animal", 2, "animais")
+	check("pt", "papel", 2, "papéis")
+	check("pt", "homem", 2, "homens")
+	check("pt", "mão", 2, "mãos")
+	check("pt", "lápis", 2, "lápis")
+	check("pt", "mês", 2, "meses")
+	check("pt", "inglês", 2, "ingleses")
+	check("pt", "flores", 2, "flores")
+	check("pt", "mães", 2, "mães")
+	check("pt", "homens", 2, "homens")
+	check("pt", "táxis", 2, "táxis")
+	check("pt", "papéis", 2, "papéis")
+	check("pt", "canções", 2, "canções")
+	check("pt", "meses", 2, "meses")
+
+	// regional tags fall back to the base language
+	check("pt-BR", "flor", 2, "flores")
+	check("pt_br", "flor", 2, "flores")
+	check("FR", "cheval", 2, "chevaux")
+
+	// unknown languages fall back to English
+	check("xx", "child", 2, "children")
+	check("", "child", 2, "children")
+}
+
+func TestSingularIn(t *testing.T) {
+
+	check := func(lang, word, expected string) {
+		actual := SingularIn(lang, word)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("es", "casas", "casa")
+	check("es", "papeles", "papel")
+	check("es", "luces", "luz")
+	check("es", "canciones", "canción")
+	check("es", "autobuses", "autobús")
+	check("es", "clases", "clase")
+	check("es", "meses", "mes")
+	check("es", "leones", "león")
+	check("es", "corazones", "corazón")
+	check("es", "ciclones", "ciclón")
+	check("es", "clones", "clon")
+	check("es", "dones", "don")
+	check("es", "coches", "coche")
+	check("es", "noches", "noche")
+	check("es", "calles", "calle")
+	check("es", "estudiantes", "estudiante")
+	check("es", "padres", "padre")
+	check("es", "nombres", "nombre")
+	check("es", "flores", "flor")
+	check("es", "ciudades", "ciudad")
+	check("es", "relojes", "reloj")
+	check("es", "leyes", "ley")
+
+	check("fr", "maisons", "maison")
+	check("fr", "bateaux", "bateau")
+	check("fr", "jeux", "jeu")
+	check("fr", "tuyaux", "tuyau")
+	check("fr", "chevaux", "cheval")
+	check("fr", "bijoux", "bijou")
+	check("fr", "travaux", "travail")
+	check("fr", "Yeux", "Œil")
+	check("fr", "souris", "souris")
+
+	check("de", "Blumen", "Blume")
+	check("de", "Lehrerinnen", "Lehrerin")
+	check("de", "Zeitungen", "Zeitung")
+	check("de", "Autos", "Auto")
+	check("de", "Ergebnisse", "Ergebnis")
+	check("de", "Männer", "Mann")
+	check("de", "Hunde", "Hund")
+	check("de", "Museen", "Museum")
+	check("de", "Studien", "Studium")
+	check("de", "Zentren", "Zentrum")
+	check("de", "Hund", "Hund")
+	check("de", "Tische", "Tisch")
+	check("de", "Dokumente", "Dokument")
+	check("de", "Produkte", "Produkt")
+	check("de", "Lehrlinge", "Lehrling")
+	check("de", "Busse", "Bus")
+	check("de", "Blume", "Blume")
+
+	check("pt", "carros", "carro")
+	check("pt", "flores", "flor")
+	check("pt", "canções", "canção")
+	check("pt", "animais", "animal")
+	check("pt", "papéis", "papel")
+	check("pt", "homens", "homem")
+	check("pt", "cães", "cão")
+	check("pt", "mães", "mãe")
+	check("pt", "pães", "pão")
+	check("pt", "táxis", "táxi")
+	check("pt", "júris", "júri")
+	check("pt", "javalis", "javali")
+	check("pt", "barris", "barril")
+	check("pt", "funis", "funil")
+	check("pt", "meses", "mês")
+	check("pt", "ingleses", "inglês")
+	check("pt", "teses", "tese")
+	check("pt", "hipóteses", "hipótese")
+}
+
+func TestInflectorRoundTrip(t *testing.T) {
+
+	check := func(lang string, words ...string) {
+		for _, word := range words {
+			plural := PluralIn(lang, word, 2)
+			if actual := SingularIn(lang, plural); actual != word {
+				t.Errorf("Expected <%s> got <%s> from <%s> in <%s>", word, actual, plural, lang)
+			}
+		}
+	}
+
+	check("en", "user", "child", "person", "category", "box", "leaf", "analysis")
+	check("es", "casa", "papel", "luz", "canción", "león", "corazón", "autobús", "inglés", "mes", "clon", "joven",
+		"coche", "noche", "calle", "estudiante", "padre", "flor", "ciudad", "ley")
+	check("fr", "maison", "bateau", "cheval", "bijou", "travail", "jeu", "œil")
+	check("de", "hund", "blume", "lehrer", "lehrerin", "zeitung", "auto", "ergebnis", "museum", "zentrum", "mann",
+		"tisch", "dokument", "projekt", "lehrling", "bus", "termin", "preis")
+	check("pt", "carro", "flor", "canção", "animal", "papel", "homem", "cão", "mãe", "mão", "táxi", "barril", "javali",
+		"mês", "inglês", "tese")
+}
+
+func TestRegisterInflector(t *testing.T) {
+
+	dutch := NewInflector()
+	dutch.AddPluralRule(`$`, "en")
+	dutch.AddPluralRule(`([aiou])$`, "${1}'s")
+	dutch.AddSingularRule(`en$`, "")
+
+	inflectorsMu.RLock()
+	saved, registered := inflectors["nl"]
+	inflectorsMu.RUnlock()
+
+	t.Cleanup(func() {
+		inflectorsMu.Lock()
+		defer inflectorsMu.Unlock()
+
+		if registered {
+			inflectors["nl"] = saved
+		} else {
+			delete(inflectors, "nl")
+		}
+	})
+
+	RegisterInflector("nl", dutch)
+
+	check := func(actual, expected string) {
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check(PluralIn("nl", "boek", 2), "boeken")
+	check(PluralIn("nl-BE", "auto", 2), "auto's")
+	check(SingularIn("nl", "boeken"), "boek")
+	check(PluralStudlyIn("nl", "MijnBoek", 2), "MijnBoeken")
+
+	if InflectorFor("nl") != dutch {
+		t.Errorf("Expected registered inflector for <nl>")
+	}
+}
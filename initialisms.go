@@ -0,0 +1,127 @@
+package str
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/chr15k/go-strings/internal/utils"
+)
+
+// Initialisms overrides the registered initialisms for a single call.
+// Keys are upper-case words; a true value treats the word as an initialism
+// and a false value stops a registered word from being treated as one.
+type Initialisms map[string]bool
+
+var (
+	initialismsMu sync.RWMutex
+
+	// Seeded with the initialisms recognised by golint.
+	initialisms = map[string]bool{
+		"ACL": true, "API": true, "ASCII": true, "CPU": true, "CSS": true,
+		"DNS": true, "EOF": true, "GUID": true, "HTML": true, "HTTP": true,
+		"HTTPS": true, "ID": true, "IP": true, "JSON": true, "LHS": true,
+		"QPS": true, "RAM": true, "RHS": true, "RPC": true, "SLA": true,
+		"SMTP": true, "SQL": true, "SSH": true, "TCP": true, "TLS": true,
+		"TTL": true, "UDP": true, "UI": true, "UID": true, "UUID": true,
+		"URI": true, "URL": true, "UTF8": true, "VM": true, "XML": true,
+		"XMPP": true, "XSRF": true, "XSS": true,
+	}
+)
+
+// Register the given words as initialisms.
+func AddInitialisms(words ...string) {
+	initialismsMu.Lock()
+	defer initialismsMu.Unlock()
+
+	for _, word := range words {
+		if len(word) > 0 {
+			initialisms[Upper(word)] = true
+		}
+	}
+}
+
+// Remove the given words from the registered initialisms.
+func RemoveInitialisms(words ...string) {
+	initialismsMu.Lock()
+	defer initialismsMu.Unlock()
+
+	for _, word := range words {
+		delete(initialisms, Upper(word))
+	}
+}
+
+// Get the registered initialisms in alphabetical order.
+func RegisteredInitialisms() []string {
+	initialismsMu.RLock()
+	defer initialismsMu.RUnlock()
+
+	words := make([]string, 0, len(initialisms))
+	for word := range initialisms {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+
+	return words
+}
+
+// Determine if a given word is a registered initialism.
+func IsInitialism(word string) bool {
+	return Initialisms(nil).has(word)
+}
+
+// Determine if a word is an initialism, checking the overrides before the registry.
+func (o Initialisms) has(word string) bool {
+	word = Upper(word)
+
+	if is, ok := o[word]; ok {
+		return is
+	}
+
+	initialismsMu.RLock()
+	defer initialismsMu.RUnlock()
+
+	return initialisms[word]
+}
+
+// Split a value into words, breaking upper-case runs such as "HTTPURL" into
+// their initialisms when the whole run is made up of them.
+func splitWords(value string, overrides Initialisms) []string {
+	words := make([]string, 0)
+
+	for _, word := range utils.SplitWords(value) {
+		words = append(words, overrides.split(word)...)
+	}
+
+	return words
+}
+
+func (o Initialisms) split(word string) []string {
+	if word != Upper(word) || o.has(word) {
+		return []string{word}
+	}
+
+	if parts, ok := o.decompose([]rune(word)); ok {
+		return parts
+	}
+
+	return []string{word}
+}
+
+// Decompose runes entirely into initialisms, preferring the longest prefix.
+func (o Initialisms) decompose(runes []rune) ([]string, bool) {
+	if len(runes) == 0 {
+		return []string{}, true
+	}
+
+	for i := len(runes); i > 0; i-- {
+		prefix := string(runes[:i])
+		if !o.has(prefix) {
+			continue
+		}
+		if rest, ok := o.decompose(runes[i:]); ok {
+			return append([]string{prefix}, rest...), true
+		}
+	}
+
+	return nil, false
+}
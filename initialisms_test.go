@@ -0,0 +1,100 @@
+package str
+
+import "testing"
+
+func TestInitialismsRegistry(t *testing.T) {
+
+	if !IsInitialism("id") || !IsInitialism("HTTP") {
+		t.Errorf("Expected golint initialisms to be registered")
+	}
+	if IsInitialism("SKU") {
+		t.Errorf("Expected <SKU> not to be registered")
+	}
+
+	AddInitialisms("sku")
+	defer RemoveInitialisms("SKU")
+
+	if !IsInitialism("SKU") {
+		t.Errorf("Expected <SKU> to be registered")
+	}
+	if actual := Studly("product_sku"); actual != "ProductSKU" {
+		t.Errorf("Expected <%s> got <%s>", "ProductSKU", actual)
+	}
+
+	RemoveInitialisms("sku")
+
+	if IsInitialism("SKU") {
+		t.Errorf("Expected <SKU> to be removed")
+	}
+
+	found := false
+	for _, word := range RegisteredInitialisms() {
+		if word == "URL" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected <URL> in registered initialisms")
+	}
+}
+
+func TestCamelWith(t *testing.T) {
+
+	check := func(value string, overrides Initialisms, expected string) {
+		actual := CamelWith(value, overrides)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("user_id", nil, "userID")
+	check("user_id", Initialisms{"ID": false}, "userId")
+	check("order_sku", Initialisms{"SKU": true}, "orderSKU")
+	check("id_token", nil, "idToken")
+}
+
+func TestStudlyWith(t *testing.T) {
+
+	check := func(value string, overrides Initialisms, expected string) {
+		actual := StudlyWith(value, overrides)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("http_url", nil, "HTTPURL")
+	check("http_url", Initialisms{"URL": false}, "HTTPUrl")
+	check("xml_http_request", nil, "XMLHTTPRequest")
+	check("utf8_string", nil, "UTF8String")
+}
+
+func TestSnakeWith(t *testing.T) {
+
+	check := func(value string, overrides Initialisms, expected string) {
+		actual := SnakeWith(value, overrides)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("HTTPURLParser", nil, "http_url_parser")
+	check("HTTPSURL", nil, "https_url")
+	check("XMLHTTPRequest", nil, "xml_http_request")
+	check("HTTPURLParser", Initialisms{"URL": false}, "httpurl_parser")
+	check("SKUID", Initialisms{"SKU": true}, "sku_id")
+	check("FOOID", nil, "fooid")
+}
+
+func TestKebabWith(t *testing.T) {
+
+	check := func(value string, overrides Initialisms, expected string) {
+		actual := KebabWith(value, overrides)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("HTTPURLParser", nil, "http-url-parser")
+	check("userID", nil, "user-id")
+	check("SKUID", Initialisms{"SKU": true}, "sku-id")
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"regexp"
 	"strings"
+	"unicode"
 )
 
 // Convert wildcard to regex pattern
@@ -20,3 +21,104 @@ func WildCardToRegexp(pattern string) string {
 	}
 	return "^" + result.String() + "$"
 }
+
+// Character classes used when looking for word boundaries.
+const (
+	runeSeparator = iota
+	runeUpper
+	runeLower
+	runeDigit
+	runeOther
+)
+
+func runeClass(r rune) int {
+	switch {
+	case unicode.IsUpper(r), unicode.IsTitle(r):
+		return runeUpper
+	case unicode.IsLower(r):
+		return runeLower
+	case unicode.IsDigit(r):
+		return runeDigit
+	case unicode.IsLetter(r), unicode.IsNumber(r):
+		return runeOther
+	}
+	return runeSeparator
+}
+
+// Split a value into words on separators and case changes.
+//
+// Any rune that is not a letter, digit or combining mark acts as a separator.
+// Within a run of letters and digits a new word starts at a lower-case to
+// upper-case change ("fooBar"), at the last capital of an acronym run that is
+// followed by lower-case letters ("HTTPServer") other than a plural "s" ending
+// the word ("userIDs", "APIsFor"), and at a capital that follows
+// a digit or a caseless letter ("2FA"). Digits stay attached to the word they
+// follow ("foo1Bar" gives "foo1" and "Bar"); combining marks stay attached to
+// their base letter.
+func SplitWords(value string) []string {
+	runes := []rune(value)
+	words := make([]string, 0)
+
+	// index of the first rune at or after i that is not a combining mark, so
+	// that accents never influence a boundary decision.
+	skipMarks := func(i int) int {
+		for i < len(runes) && unicode.IsMark(runes[i]) {
+			i++
+		}
+		return i
+	}
+
+	classAt := func(i int) int {
+		if i = skipMarks(i); i < len(runes) {
+			return runeClass(runes[i])
+		}
+		return runeSeparator
+	}
+
+	// an acronym keeps a plural "s" followed by a boundary, as in "userIDs"
+	pluralAt := func(i int) bool {
+		i = skipMarks(i)
+		return i < len(runes) && runes[i] == 's' && classAt(i+1) != runeLower
+	}
+
+	start := -1
+	prev := runeSeparator
+
+	for i, r := range runes {
+		if unicode.IsMark(r) {
+			continue
+		}
+
+		class := runeClass(r)
+
+		if class == runeSeparator {
+			if start >= 0 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+			prev = class
+			continue
+		}
+
+		if start < 0 {
+			start = i
+		} else if class == runeUpper {
+			boundary := prev == runeLower || prev == runeDigit || prev == runeOther
+			if prev == runeUpper && classAt(i+1) == runeLower && !pluralAt(i+1) {
+				boundary = true
+			}
+			if boundary {
+				words = append(words, string(runes[start:i]))
+				start = i
+			}
+		}
+
+		prev = class
+	}
+
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+
+	return words
+}
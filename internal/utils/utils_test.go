@@ -1,6 +1,9 @@
 package utils
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestWildCardToRegexp(t *testing.T) {
 
@@ -15,3 +18,37 @@ func TestWildCardToRegexp(t *testing.T) {
 	check("chris", "^chris$")
 	check("chris*", "^chris.*$")
 }
+
+func TestSplitWords(t *testing.T) {
+
+	check := func(subject string, expected []string) {
+		actual := SplitWords(subject)
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("Expected <%+v> got <%+v>", expected, actual)
+		}
+	}
+
+	check("", []string{})
+	check("  -_-  ", []string{})
+	check("foo", []string{"foo"})
+	check("fooBarBaz", []string{"foo", "Bar", "Baz"})
+	check("FooBar", []string{"Foo", "Bar"})
+	check("foo_bar-baz qux", []string{"foo", "bar", "baz", "qux"})
+	check("foo.bar/baz", []string{"foo", "bar", "baz"})
+	check("Golang  -_-  string   -_-   helpers   ", []string{"Golang", "string", "helpers"})
+	check("HTTPServerID", []string{"HTTP", "Server", "ID"})
+	check("userID2FA", []string{"user", "ID2", "FA"})
+	check("foo1Bar", []string{"foo1", "Bar"})
+	check("userIDs", []string{"user", "IDs"})
+	check("ListAPIs", []string{"List", "APIs"})
+	check("URLs", []string{"URLs"})
+	check("APIsForURLs", []string{"APIs", "For", "URLs"})
+	check("IDs_v2", []string{"IDs", "v2"})
+	check("IDsuffix", []string{"I", "Dsuffix"})
+	check("1 foo bar", []string{"1", "foo", "bar"})
+	check("ŻółtaŁódka", []string{"Żółta", "Łódka"})
+	check("ÉcoleNormale", []string{"École", "Normale"})
+	check("CaféNoir", []string{"Café", "Noir"})
+	check("Cafe\u0301Noir", []string{"Cafe\u0301", "Noir"})
+	check("中文Foo", []string{"中文", "Foo"})
+}
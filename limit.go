@@ -0,0 +1,190 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Truncation selects the part of a string removed by LimitWith and WordsWith.
+type Truncation int
+
+const (
+	TruncateEnd Truncation = iota
+	TruncateStart
+	TruncateMiddle
+)
+
+// LimitOptions configures LimitWith.
+type LimitOptions struct {
+	// Marker added where the string was truncated, "..." when empty.
+	End string
+
+	// Part of the string to remove, the end by default.
+	Truncate Truncation
+
+	// Drop a word cut by the limit instead of cutting it part way, unless
+	// that would leave nothing.
+	PreserveWords bool
+
+	// Break a word cut at the end of the kept text at its last legal
+	// hyphenation point that fits, adding a "-", or drop the word when there
+	// is none. A word cut at the start of the kept text is dropped.
+	Hyphenate bool
+
+	// Language tag of the hyphenation patterns, English when empty.
+	Language string
+
+	// Remove punctuation and spaces next to the marker.
+	TrimPunctuation bool
+
+	// Count the marker toward the limit, so the result never exceeds it. A
+	// marker longer than the limit is left out.
+	CountEnd bool
+}
+
+// Limit the number of user-perceived characters in a string using the given options.
+func LimitWith(value string, limit int, opts LimitOptions) string {
+	clusters := Graphemes(value)
+
+	if len(clusters) <= limit || limit <= 0 {
+		return value
+	}
+
+	end := opts.End
+	if len(end) == 0 {
+		end = "..."
+	}
+
+	if opts.CountEnd && GraphemeLength(end) > limit {
+		end = ""
+	} else if opts.CountEnd {
+		limit -= GraphemeLength(end)
+	}
+
+	switch opts.Truncate {
+	case TruncateStart:
+		return end + limitStart(clusters, limit, opts)
+	case TruncateMiddle:
+		return limitEnd(clusters, (limit+1)/2, opts) + end + limitStart(clusters, limit/2, opts)
+	}
+
+	return limitEnd(clusters, limit, opts) + end
+}
+
+// Keep the first limit clusters, honouring the word and punctuation options.
+func limitEnd(clusters []string, limit int, opts LimitOptions) string {
+	start := limit
+	for start > 0 && limit < len(clusters) && isWordCluster(clusters[limit]) && isWordCluster(clusters[start-1]) {
+		start--
+	}
+
+	if opts.Hyphenate && start < limit {
+		stop := limit
+		for stop < len(clusters) && isWordCluster(clusters[stop]) {
+			stop++
+		}
+
+		word := strings.Join(clusters[start:stop], "")
+		offsets := HyphenatorFor(opts.Language).breaks(word)
+
+		for i := len(offsets) - 1; i >= 0; i-- {
+			if start+GraphemeLength(word[:offsets[i]])+1 <= limit {
+				return strings.Join(clusters[:start], "") + word[:offsets[i]] + "-"
+			}
+		}
+	}
+
+	kept := strings.Join(clusters[:limit], "")
+
+	if (opts.PreserveWords || opts.Hyphenate) && start > 0 && start < limit {
+		kept = strings.TrimRightFunc(strings.Join(clusters[:start], ""), unicode.IsSpace)
+	} else if opts.PreserveWords {
+		kept = strings.TrimRightFunc(kept, unicode.IsSpace)
+	}
+
+	if opts.TrimPunctuation {
+		kept = strings.TrimRightFunc(kept, isPunctuationOrSpace)
+	}
+
+	return kept
+}
+
+// Keep the last limit clusters, honouring the word and punctuation options.
+func limitStart(clusters []string, limit int, opts LimitOptions) string {
+	from := len(clusters) - limit
+	start := from
+	for start < len(clusters) && from > 0 && isWordCluster(clusters[from-1]) && isWordCluster(clusters[start]) {
+		start++
+	}
+
+	kept := strings.Join(clusters[from:], "")
+
+	if (opts.PreserveWords || opts.Hyphenate) && start > from && start < len(clusters) {
+		kept = strings.TrimLeftFunc(strings.Join(clusters[start:], ""), unicode.IsSpace)
+	} else if opts.PreserveWords {
+		kept = strings.TrimLeftFunc(kept, unicode.IsSpace)
+	}
+
+	if opts.TrimPunctuation {
+		kept = strings.TrimLeftFunc(kept, isPunctuationOrSpace)
+	}
+
+	return kept
+}
+
+// WordsOptions configures WordsWith.
+type WordsOptions struct {
+	// Marker added where the string was truncated, "..." when empty.
+	End string
+
+	// Part of the string to remove, the end by default. The marker is set
+	// apart by spaces when the middle is removed.
+	Truncate Truncation
+
+	// Remove punctuation next to the marker.
+	TrimPunctuation bool
+}
+
+// Limit the number of words in a string using the given options.
+func WordsWith(value string, words int, opts WordsOptions) string {
+	fields := strings.Fields(value)
+
+	if len(fields) <= words || words < 0 {
+		return value
+	}
+
+	end := opts.End
+	if len(end) == 0 {
+		end = "..."
+	}
+
+	head := func(n int) string {
+		kept := strings.Join(fields[:n], " ")
+		if opts.TrimPunctuation {
+			kept = strings.TrimRightFunc(kept, isPunctuationOrSpace)
+		}
+		return kept
+	}
+
+	tail := func(n int) string {
+		kept := strings.Join(fields[len(fields)-n:], " ")
+		if opts.TrimPunctuation {
+			kept = strings.TrimLeftFunc(kept, isPunctuationOrSpace)
+		}
+		return kept
+	}
+
+	switch opts.Truncate {
+	case TruncateStart:
+		return end + tail(words)
+	case TruncateMiddle:
+		return strings.TrimSpace(head((words+1)/2) + " " + end + " " + tail(words/2))
+	}
+
+	return head(words) + end
+}
+
+// Determine if a rune is punctuation or white space.
+func isPunctuationOrSpace(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSpace(r)
+}
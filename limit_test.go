@@ -0,0 +1,92 @@
+package str
+
+import "testing"
+
+func TestLimitWith(t *testing.T) {
+
+	check := func(value string, limit int, opts LimitOptions, expected string) {
+		actual := LimitWith(value, limit, opts)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	s := "Internationalization is hard"
+
+	check(s, 12, LimitOptions{}, "Internationa...")
+	check(s, 100, LimitOptions{}, s)
+	check(s, 0, LimitOptions{}, s)
+
+	// hyphenation
+	hyphenate := LimitOptions{Hyphenate: true}
+
+	check(s, 12, hyphenate, "Internation-...")
+	check(s, 10, hyphenate, "Interna-...")
+	check(s, 6, hyphenate, "Inter-...")
+	check(s, 20, hyphenate, "Internationalization...")
+	check(s, 21, hyphenate, "Internationalization ...")
+	check("The hyphenation rules", 10, hyphenate, "The hy-...")
+	check("The hyphenation rules", 5, hyphenate, "The...")
+	check("Supercalifragilistic", 1, hyphenate, "S...")
+	check("Un été à Montréal", 15, hyphenate, "Un été à Mon-...")
+
+	// end marker
+	check(s, 12, LimitOptions{End: "…"}, "Internationa…")
+	check(s, 12, LimitOptions{End: "…", CountEnd: true}, "Internation…")
+	check(s, 12, LimitOptions{End: " [more]", CountEnd: true}, "Inter [more]")
+	check(s, 2, LimitOptions{End: " [more]", CountEnd: true}, "In")
+	check(s, 2, LimitOptions{End: " [more]", CountEnd: true, Truncate: TruncateStart}, "rd")
+	check(s, 7, LimitOptions{End: " [more]", CountEnd: true}, " [more]")
+
+	// whole words
+	words := LimitOptions{PreserveWords: true}
+	check("The quick brown fox", 12, words, "The quick...")
+	check("The quick brown fox", 9, words, "The quick...")
+	check("The quick brown fox", 10, words, "The quick...")
+	check("The quick brown fox", 2, words, "Th...")
+	check(s, 22, LimitOptions{PreserveWords: true, End: "…", CountEnd: true}, "Internationalization…")
+
+	// punctuation
+	check("Hello, world. Again", 6, LimitOptions{TrimPunctuation: true}, "Hello...")
+	check("Hello, world. Again", 15, LimitOptions{PreserveWords: true, TrimPunctuation: true}, "Hello, world...")
+	check("Hello, world. Again", 15, words, "Hello, world....")
+
+	// start and middle
+	path := "/usr/local/share/applications/editor.desktop"
+	check(path, 20, LimitOptions{Truncate: TruncateStart}, "...tions/editor.desktop")
+	check(path, 20, LimitOptions{Truncate: TruncateStart, PreserveWords: true}, ".../editor.desktop")
+	check(path, 20, LimitOptions{Truncate: TruncateStart, TrimPunctuation: true, PreserveWords: true}, "...editor.desktop")
+	check(path, 20, LimitOptions{Truncate: TruncateMiddle}, "/usr/local...or.desktop")
+	check(path, 20, LimitOptions{Truncate: TruncateMiddle, End: "…", CountEnd: true}, "/usr/local…r.desktop")
+	check(path, 24, LimitOptions{Truncate: TruncateMiddle, PreserveWords: true}, "/usr/local/....desktop")
+	check(path, 24, LimitOptions{Truncate: TruncateMiddle, PreserveWords: true, TrimPunctuation: true}, "/usr/local...desktop")
+
+	// user-perceived characters
+	check("Cafe\u0301 au lait", 4, LimitOptions{}, "Cafe\u0301...")
+	check("👍🏽👍🏽👍🏽", 2, LimitOptions{Truncate: TruncateMiddle, End: "…"}, "👍🏽…👍🏽")
+}
+
+func TestWordsWith(t *testing.T) {
+
+	check := func(value string, words int, opts WordsOptions, expected string) {
+		actual := WordsWith(value, words, opts)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	s := "Lorem ipsum dolor sit amet, consectetur adipiscing elit."
+
+	check(s, 2, WordsOptions{}, "Lorem ipsum...")
+	check(s, 100, WordsOptions{}, s)
+	check(s, 0, WordsOptions{}, "...")
+	check(s, 2, WordsOptions{End: "…"}, "Lorem ipsum…")
+	check(s, 5, WordsOptions{End: "…"}, "Lorem ipsum dolor sit amet,…")
+	check(s, 5, WordsOptions{End: "…", TrimPunctuation: true}, "Lorem ipsum dolor sit amet…")
+	check(s, 2, WordsOptions{Truncate: TruncateStart}, "...adipiscing elit.")
+	check("Well, hello there", 2, WordsOptions{Truncate: TruncateStart, TrimPunctuation: true}, "...hello there")
+	check(s, 3, WordsOptions{Truncate: TruncateMiddle, End: "…"}, "Lorem ipsum … elit.")
+	check(s, 4, WordsOptions{Truncate: TruncateMiddle, End: "…", TrimPunctuation: true}, "Lorem ipsum … adipiscing elit.")
+	check(s, 1, WordsOptions{Truncate: TruncateMiddle}, "Lorem ...")
+	check("   ", 1, WordsOptions{}, "   ")
+}
@@ -0,0 +1,841 @@
+package str
+
+import (
+	"strings"
+)
+
+// Fold a name to the upper-case ASCII letters the phonetic encoders work on,
+// transliterating accented letters and keeping single spaces between words.
+// "Ç" is read as "S", as in the reference Double Metaphone.
+func phoneticLetters(value string) string {
+	value = strings.NewReplacer("Ç", "S", "ç", "S").Replace(value)
+	folded := []byte(Upper(Ascii(value, "")))
+
+	letters := make([]byte, 0, len(folded))
+	for _, c := range folded {
+		switch {
+		case c >= 'A' && c <= 'Z':
+			letters = append(letters, c)
+		case c == ' ' || c == '-' || c == '\t':
+			letters = append(letters, ' ')
+		}
+	}
+
+	return strings.Join(strings.Fields(string(letters)), " ")
+}
+
+// Get the American Soundex code of a name: its first letter followed by three
+// digits for the consonant sounds that follow.
+func Soundex(value string) string {
+	letters := strings.ReplaceAll(phoneticLetters(value), " ", "")
+
+	if len(letters) == 0 {
+		return ""
+	}
+
+	digit := func(c byte) byte {
+		switch c {
+		case 'B', 'F', 'P', 'V':
+			return '1'
+		case 'C', 'G', 'J', 'K', 'Q', 'S', 'X', 'Z':
+			return '2'
+		case 'D', 'T':
+			return '3'
+		case 'L':
+			return '4'
+		case 'M', 'N':
+			return '5'
+		case 'R':
+			return '6'
+		}
+		return 0
+	}
+
+	code := []byte{letters[0]}
+	last := digit(letters[0])
+
+	for i := 1; i < len(letters) && len(code) < 4; i++ {
+		c := letters[i]
+
+		// H and W do not separate letters with the same code
+		if c == 'H' || c == 'W' {
+			continue
+		}
+
+		d := digit(c)
+		if d != 0 && d != last {
+			code = append(code, d)
+		}
+		last = d
+	}
+
+	for len(code) < 4 {
+		code = append(code, '0')
+	}
+
+	return string(code)
+}
+
+// Get the Metaphone code of a word, as described by Lawrence Philips in 1990.
+// "0" stands for the "th" sound and "X" for "sh".
+func Metaphone(value string) string {
+	word := strings.ReplaceAll(phoneticLetters(value), " ", "")
+
+	if len(word) <= 1 {
+		return word
+	}
+
+	// initial letter exceptions
+	switch {
+	case strings.HasPrefix(word, "AE"), strings.HasPrefix(word, "GN"), strings.HasPrefix(word, "KN"),
+		strings.HasPrefix(word, "PN"), strings.HasPrefix(word, "WR"):
+		word = word[1:]
+	case strings.HasPrefix(word, "WH"):
+		word = "W" + word[2:]
+	case word[0] == 'X':
+		word = "S" + word[1:]
+	}
+
+	at := func(i int) byte {
+		if i < 0 || i >= len(word) {
+			return 0
+		}
+		return word[i]
+	}
+	isVowel := func(i int) bool {
+		return strings.IndexByte("AEIOU", at(i)) >= 0 && at(i) != 0
+	}
+	isFrontVowel := func(i int) bool {
+		return strings.IndexByte("EIY", at(i)) >= 0 && at(i) != 0
+	}
+	last := len(word) - 1
+
+	var code strings.Builder
+
+	for n := 0; n < len(word); n++ {
+		c := word[n]
+
+		// skip duplicate letters, except C
+		if c != 'C' && at(n-1) == c {
+			continue
+		}
+
+		switch c {
+		case 'A', 'E', 'I', 'O', 'U':
+			if n == 0 {
+				code.WriteByte(c)
+			}
+		case 'B':
+			// silent in a final "MB"
+			if !(at(n-1) == 'M' && n == last) {
+				code.WriteByte('B')
+			}
+		case 'C':
+			switch {
+			case at(n-1) == 'S' && isFrontVowel(n+1):
+				// silent in "SCI", "SCE" and "SCY"
+			case strings.HasPrefix(word[n:], "CIA"):
+				code.WriteByte('X')
+			case isFrontVowel(n + 1):
+				code.WriteByte('S')
+			case at(n-1) == 'S' && at(n+1) == 'H':
+				code.WriteByte('K')
+			case at(n+1) == 'H':
+				if n == 0 && !isVowel(2) {
+					code.WriteByte('K')
+				} else {
+					code.WriteByte('X')
+				}
+			default:
+				code.WriteByte('K')
+			}
+		case 'D':
+			if at(n+1) == 'G' && isFrontVowel(n+2) {
+				code.WriteByte('J')
+				n += 2
+			} else {
+				code.WriteByte('T')
+			}
+		case 'G':
+			switch {
+			case at(n+1) == 'H' && (n+1 == last || !isVowel(n+2)):
+				// silent in "GH" at the end or before a consonant
+			case n > 0 && at(n+1) == 'N':
+				// silent in "GN" and "GNED"
+			case isFrontVowel(n + 1):
+				code.WriteByte('J')
+			default:
+				code.WriteByte('K')
+			}
+		case 'H':
+			if n < last && strings.IndexByte("CSPTG", at(n-1)) < 0 && isVowel(n+1) {
+				code.WriteByte('H')
+			}
+		case 'K':
+			if at(n-1) != 'C' {
+				code.WriteByte('K')
+			}
+		case 'P':
+			if at(n+1) == 'H' {
+				code.WriteByte('F')
+			} else {
+				code.WriteByte('P')
+			}
+		case 'Q':
+			code.WriteByte('K')
+		case 'S':
+			if at(n+1) == 'H' || strings.HasPrefix(word[n:], "SIO") || strings.HasPrefix(word[n:], "SIA") {
+				code.WriteByte('X')
+			} else {
+				code.WriteByte('S')
+			}
+		case 'T':
+			switch {
+			case strings.HasPrefix(word[n:], "TIA"), strings.HasPrefix(word[n:], "TIO"):
+				code.WriteByte('X')
+			case strings.HasPrefix(word[n:], "TCH"):
+				// silent
+			case at(n+1) == 'H':
+				code.WriteByte('0')
+			default:
+				code.WriteByte('T')
+			}
+		case 'V':
+			code.WriteByte('F')
+		case 'W', 'Y':
+			if isVowel(n + 1) {
+				code.WriteByte(c)
+			}
+		case 'X':
+			code.WriteString("KS")
+		case 'Z':
+			code.WriteByte('S')
+		default:
+			code.WriteByte(c)
+		}
+	}
+
+	return code.String()
+}
+
+// Get the New York State Identification and Intelligence System code of a
+// name, truncated to six letters.
+func NYSIIS(value string) string {
+	name := strings.ReplaceAll(phoneticLetters(value), " ", "")
+
+	if len(name) == 0 {
+		return ""
+	}
+
+	for _, prefix := range [][2]string{{"MAC", "MCC"}, {"KN", "NN"}, {"K", "C"}, {"PH", "FF"}, {"PF", "FF"}, {"SCH", "SSS"}} {
+		if strings.HasPrefix(name, prefix[0]) {
+			name = prefix[1] + name[len(prefix[0]):]
+			break
+		}
+	}
+
+	for _, suffix := range [][2]string{{"EE", "Y"}, {"IE", "Y"}, {"DT", "D"}, {"RT", "D"}, {"RD", "D"}, {"NT", "D"}, {"ND", "D"}} {
+		if strings.HasSuffix(name, suffix[0]) {
+			name = name[:len(name)-len(suffix[0])] + suffix[1]
+			break
+		}
+	}
+
+	chars := []byte(name)
+	isVowel := func(c byte) bool {
+		return strings.IndexByte("AEIOU", c) >= 0 && c != 0
+	}
+	at := func(i int) byte {
+		if i >= len(chars) {
+			return 0
+		}
+		return chars[i]
+	}
+
+	key := []byte{chars[0]}
+
+	for i := 1; i < len(chars); i++ {
+		prev, c, next := chars[i-1], chars[i], at(i+1)
+
+		replacement := string(c)
+		switch {
+		case c == 'E' && next == 'V':
+			replacement = "AF"
+		case isVowel(c):
+			replacement = "A"
+		case c == 'Q':
+			replacement = "G"
+		case c == 'Z':
+			replacement = "S"
+		case c == 'M':
+			replacement = "N"
+		case c == 'K' && next == 'N':
+			replacement = "NN"
+		case c == 'K':
+			replacement = "C"
+		case c == 'S' && next == 'C' && at(i+2) == 'H':
+			replacement = "SSS"
+		case c == 'P' && next == 'H':
+			replacement = "FF"
+		case c == 'H' && (!isVowel(prev) || !isVowel(next)):
+			replacement = string(prev)
+		case c == 'W' && isVowel(prev):
+			replacement = string(prev)
+		}
+
+		copy(chars[i:], replacement)
+
+		if chars[i] != chars[i-1] {
+			key = append(key, chars[i])
+		}
+	}
+
+	if len(key) > 1 {
+		if key[len(key)-1] == 'S' {
+			key = key[:len(key)-1]
+		}
+		if len(key) > 2 && string(key[len(key)-2:]) == "AY" {
+			key = append(key[:len(key)-2], 'Y')
+		}
+		if key[len(key)-1] == 'A' {
+			key = key[:len(key)-1]
+		}
+	}
+
+	if len(key) > 6 {
+		key = key[:6]
+	}
+
+	return string(key)
+}
+
+// Maximum length of the Double Metaphone codes.
+const doubleMetaphoneLength = 4
+
+// Get the primary and alternate Double Metaphone codes of a name, as described
+// by Lawrence Philips in 2000. The codes are at most four letters long, and
+// the alternate code differs from the primary one for names with a second
+// common pronunciation, such as "Schmidt" (XMT and SMT).
+func DoubleMetaphone(value string) (string, string) {
+	m := &doubleMetaphone{value: phoneticLetters(value)}
+
+	if len(m.value) == 0 {
+		return "", ""
+	}
+
+	m.slavoGermanic = strings.ContainsAny(m.value, "WK") || strings.Contains(m.value, "CZ")
+
+	i := 0
+	if m.contains(0, "GN", "KN", "PN", "WR", "PS") {
+		i = 1
+	}
+
+	for i < len(m.value) && (len(m.primary) < doubleMetaphoneLength || len(m.alternate) < doubleMetaphoneLength) {
+		i = m.encode(i)
+	}
+
+	return string(m.primary), string(m.alternate)
+}
+
+// State of a Double Metaphone encoding.
+type doubleMetaphone struct {
+	value              string
+	primary, alternate []byte
+	slavoGermanic      bool
+}
+
+// Get the letter at i, or 0 out of range.
+func (m *doubleMetaphone) at(i int) byte {
+	if i < 0 || i >= len(m.value) {
+		return 0
+	}
+	return m.value[i]
+}
+
+// Determine if any of the strings, which share a length, appear at i.
+func (m *doubleMetaphone) contains(i int, options ...string) bool {
+	if i < 0 || i+len(options[0]) > len(m.value) {
+		return false
+	}
+	for _, option := range options {
+		if m.value[i:i+len(option)] == option {
+			return true
+		}
+	}
+	return false
+}
+
+// Determine if the letter at i is a vowel.
+func (m *doubleMetaphone) isVowel(i int) bool {
+	c := m.at(i)
+	return c != 0 && strings.IndexByte("AEIOUY", c) >= 0
+}
+
+// Determine if the name looks Germanic, going by a leading "VAN ", "VON " or "SCH".
+func (m *doubleMetaphone) isGermanic() bool {
+	return m.contains(0, "VAN ", "VON ") || m.contains(0, "SCH")
+}
+
+// Add to both codes.
+func (m *doubleMetaphone) add(code string) {
+	m.addBoth(code, code)
+}
+
+// Add to the primary and alternate codes.
+func (m *doubleMetaphone) addBoth(primary, alternate string) {
+	m.primary = append(m.primary, primary[:min(len(primary), max(doubleMetaphoneLength-len(m.primary), 0))]...)
+	m.alternate = append(m.alternate, alternate[:min(len(alternate), max(doubleMetaphoneLength-len(m.alternate), 0))]...)
+}
+
+// Encode the letters at i, returning the index of the next letter to encode.
+func (m *doubleMetaphone) encode(i int) int {
+	// skip a doubled letter, or one of the given letters, after the current one
+	next := func(letters string) int {
+		if c := m.at(i + 1); c != 0 && strings.IndexByte(letters, c) >= 0 {
+			return i + 2
+		}
+		return i + 1
+	}
+
+	switch c := m.value[i]; c {
+	case 'A', 'E', 'I', 'O', 'U', 'Y':
+		if i == 0 {
+			m.add("A")
+		}
+		return i + 1
+	case 'B':
+		m.add("P")
+		return next("B")
+	case 'C':
+		return m.encodeC(i)
+	case 'D':
+		switch {
+		case m.contains(i, "DG") && m.contains(i+2, "I", "E", "Y"):
+			// "edge"
+			m.add("J")
+			return i + 3
+		case m.contains(i, "DG"):
+			// "edgar"
+			m.add("TK")
+			return i + 2
+		}
+		m.add("T")
+		return next("TD")
+	case 'F':
+		m.add("F")
+		return next("F")
+	case 'G':
+		return m.encodeG(i)
+	case 'H':
+		// only kept when first or between vowels, also covering "HH"
+		if (i == 0 || m.isVowel(i-1)) && m.isVowel(i+1) {
+			m.add("H")
+			return i + 2
+		}
+		return i + 1
+	case 'J':
+		return m.encodeJ(i)
+	case 'K':
+		m.add("K")
+		return next("K")
+	case 'L':
+		if m.at(i+1) != 'L' {
+			m.add("L")
+			return i + 1
+		}
+		// Spanish "-illo", "-illa" and "-alle"
+		last := len(m.value) - 1
+		if i == last-2 && m.contains(i-1, "ILLO", "ILLA", "ALLE") ||
+			(m.contains(last-1, "AS", "OS") || m.contains(last, "A", "O")) && m.contains(i-1, "ALLE") {
+			m.addBoth("L", "")
+		} else {
+			m.add("L")
+		}
+		return i + 2
+	case 'M':
+		m.add("M")
+		// "dumb", "thumb"
+		if m.at(i+1) == 'M' || m.contains(i-1, "UMB") && (i+1 == len(m.value)-1 || m.contains(i+2, "ER")) {
+			return i + 2
+		}
+		return i + 1
+	case 'N':
+		m.add("N")
+		return next("N")
+	case 'P':
+		if m.at(i+1) == 'H' {
+			m.add("F")
+			return i + 2
+		}
+		m.add("P")
+		return next("PB")
+	case 'Q':
+		m.add("K")
+		return next("Q")
+	case 'R':
+		// French "rogier", but not "hochmeier"
+		if i == len(m.value)-1 && !m.slavoGermanic && m.contains(i-2, "IE") && !m.contains(i-4, "ME", "MA") {
+			m.addBoth("", "R")
+		} else {
+			m.add("R")
+		}
+		return next("R")
+	case 'S':
+		return m.encodeS(i)
+	case 'T':
+		return m.encodeT(i)
+	case 'V':
+		m.add("F")
+		return next("V")
+	case 'W':
+		return m.encodeW(i)
+	case 'X':
+		if i == 0 {
+			// "Xavier"
+			m.add("S")
+			return i + 1
+		}
+		// French "breaux"
+		if !(i == len(m.value)-1 && (m.contains(i-3, "IAU", "EAU") || m.contains(i-2, "AU", "OU"))) {
+			m.add("KS")
+		}
+		return next("CX")
+	case 'Z':
+		if m.at(i+1) == 'H' {
+			// Chinese pinyin "zhao"
+			m.add("J")
+			return i + 2
+		}
+		if m.contains(i+1, "ZO", "ZI", "ZA") || m.slavoGermanic && i > 0 && m.at(i-1) != 'T' {
+			m.addBoth("S", "TS")
+		} else {
+			m.add("S")
+		}
+		return next("Z")
+	}
+
+	return i + 1
+}
+
+// Encode a "C" at i.
+func (m *doubleMetaphone) encodeC(i int) int {
+	switch {
+	case i > 1 && !m.isVowel(i-2) && m.contains(i-1, "ACH") &&
+		(m.at(i+2) != 'I' && m.at(i+2) != 'E' || m.contains(i-2, "BACHER", "MACHER")):
+		// Germanic "bacher" and "macher", not "bachelor"
+		m.add("K")
+		return i + 2
+	case i == 0 && m.contains(i, "CAESAR"):
+		m.add("S")
+		return i + 2
+	case m.contains(i, "CHIA"):
+		// Italian "chianti"
+		m.add("K")
+		return i + 2
+	case m.contains(i, "CH"):
+		return m.encodeCH(i)
+	case m.contains(i, "CZ") && !m.contains(i-2, "WICZ"):
+		// "Czerny"
+		m.addBoth("S", "X")
+		return i + 2
+	case m.contains(i+1, "CIA"):
+		// "focaccia"
+		m.add("X")
+		return i + 3
+	case m.contains(i, "CC") && !(i == 1 && m.at(0) == 'M'):
+		// double "cc", but not "McClelland"
+		if m.contains(i+2, "I", "E", "H") && !m.contains(i+2, "HU") {
+			if i == 1 && m.at(0) == 'A' || m.contains(i-1, "UCCEE", "UCCES") {
+				// "accident", "accede", "succeed"
+				m.add("KS")
+			} else {
+				// Italian "bacci", "bertucci"
+				m.add("X")
+			}
+			return i + 3
+		}
+		// Pierce's rule
+		m.add("K")
+		return i + 2
+	case m.contains(i, "CK", "CG", "CQ"):
+		m.add("K")
+		return i + 2
+	case m.contains(i, "CI", "CE", "CY"):
+		// Italian and English
+		if m.contains(i, "CIO", "CIE", "CIA") {
+			m.addBoth("S", "X")
+		} else {
+			m.add("S")
+		}
+		return i + 2
+	}
+
+	m.add("K")
+
+	switch {
+	case m.contains(i+1, " C", " Q", " G"):
+		// "Mac Caffrey", "Mac Gregor"
+		return i + 3
+	case m.contains(i+1, "C", "K", "Q") && !m.contains(i+1, "CE", "CI"):
+		return i + 2
+	}
+
+	return i + 1
+}
+
+// Encode a "CH" at i.
+func (m *doubleMetaphone) encodeCH(i int) int {
+	switch {
+	case i > 0 && m.contains(i, "CHAE"):
+		// "Michael"
+		m.addBoth("K", "X")
+	case i == 0 && (m.contains(i+1, "HARAC", "HARIS") || m.contains(i+1, "HOR", "HYM", "HIA", "HEM")) && !m.contains(0, "CHORE"):
+		// Greek roots, "chemistry", "chorus"
+		m.add("K")
+	case m.isGermanic() || m.contains(i-2, "ORCHES", "ARCHIT", "ORCHID") || m.contains(i+2, "T", "S") ||
+		(i == 0 || m.contains(i-1, "A", "O", "U", "E")) &&
+			(m.contains(i+2, "L", "R", "N", "M", "B", "H", "F", "V", "W", " ") || i+1 == len(m.value)-1):
+		// Germanic, Greek, or otherwise "ch" for the "kh" sound
+		m.add("K")
+	case i == 0:
+		m.add("X")
+	case m.contains(0, "MC"):
+		// "McHugh"
+		m.add("K")
+	default:
+		m.addBoth("X", "K")
+	}
+
+	return i + 2
+}
+
+// Encode a "G" at i.
+func (m *doubleMetaphone) encodeG(i int) int {
+	switch {
+	case m.at(i+1) == 'H':
+		return m.encodeGH(i)
+	case m.at(i+1) == 'N':
+		switch {
+		case i == 1 && m.isVowel(0) && !m.slavoGermanic:
+			m.addBoth("KN", "N")
+		case !m.contains(i+2, "EY") && m.at(i+1) != 'Y' && !m.slavoGermanic:
+			// not "cagney"
+			m.addBoth("N", "KN")
+		default:
+			m.add("KN")
+		}
+		return i + 2
+	case m.contains(i+1, "LI") && !m.slavoGermanic:
+		// "tagliaro"
+		m.addBoth("KL", "L")
+		return i + 2
+	case i == 0 && (m.at(i+1) == 'Y' || m.contains(i+1, "ES", "EP", "EB", "EL", "EY", "IB", "IL", "IN", "IE", "EI", "ER")):
+		// "-ges-", "-gep-", "-gel-", "-gie-" at the start
+		m.addBoth("K", "J")
+		return i + 2
+	case (m.contains(i+1, "ER") || m.at(i+1) == 'Y') && !m.contains(0, "DANGER", "RANGER", "MANGER") &&
+		!m.contains(i-1, "E", "I") && !m.contains(i-1, "RGY", "OGY"):
+		// "-ger-", "-gy-"
+		m.addBoth("K", "J")
+		return i + 2
+	case m.contains(i+1, "E", "I", "Y") || m.contains(i-1, "AGGI", "OGGI"):
+		// Italian "biaggi"
+		switch {
+		case m.isGermanic() || m.contains(i+1, "ET"):
+			m.add("K")
+		case m.contains(i+1, "IER"):
+			m.add("J")
+		default:
+			m.addBoth("J", "K")
+		}
+		return i + 2
+	case m.at(i+1) == 'G':
+		m.add("K")
+		return i + 2
+	}
+
+	m.add("K")
+	return i + 1
+}
+
+// Encode a "GH" at i.
+func (m *doubleMetaphone) encodeGH(i int) int {
+	switch {
+	case i > 0 && !m.isVowel(i-1):
+		m.add("K")
+	case i == 0:
+		// "ghislane", "ghiradelli"
+		if m.at(i+2) == 'I' {
+			m.add("J")
+		} else {
+			m.add("K")
+		}
+	case i > 1 && m.contains(i-2, "B", "H", "D") || i > 2 && m.contains(i-3, "B", "H", "D") || i > 3 && m.contains(i-4, "B", "H"):
+		// Parker's rule, "hugh", "bough", "broughton"
+	case i > 2 && m.at(i-1) == 'U' && m.contains(i-3, "C", "G", "L", "R", "T"):
+		// "laugh", "McLaughlin", "cough", "gough", "rough", "tough"
+		m.add("F")
+	case m.at(i-1) != 'I':
+		m.add("K")
+	}
+
+	return i + 2
+}
+
+// Encode a "J" at i.
+func (m *doubleMetaphone) encodeJ(i int) int {
+	if m.contains(i, "JOSE") || m.contains(0, "SAN ") {
+		// Spanish "Jose", "San Jacinto"
+		if i == 0 && m.at(i+4) == ' ' || len(m.value) == 4 || m.contains(0, "SAN ") {
+			m.add("H")
+		} else {
+			m.addBoth("J", "H")
+		}
+		return i + 1
+	}
+
+	switch {
+	case i == 0:
+		// "Yankelovich", "Jankelowicz"
+		m.addBoth("J", "A")
+	case m.isVowel(i-1) && !m.slavoGermanic && (m.at(i+1) == 'A' || m.at(i+1) == 'O'):
+		// Spanish pronunciation of "bajador"
+		m.addBoth("J", "H")
+	case i == len(m.value)-1:
+		m.addBoth("J", "")
+	case !m.contains(i+1, "L", "T", "K", "S", "N", "M", "B", "Z") && !m.contains(i-1, "S", "K", "L"):
+		m.add("J")
+	}
+
+	if m.at(i+1) == 'J' {
+		return i + 2
+	}
+	return i + 1
+}
+
+// Encode an "S" at i.
+func (m *doubleMetaphone) encodeS(i int) int {
+	switch {
+	case m.contains(i-1, "ISL", "YSL"):
+		// "island", "isle", "carlisle", "carlysle"
+		return i + 1
+	case i == 0 && m.contains(i, "SUGAR"):
+		m.addBoth("X", "S")
+		return i + 1
+	case m.contains(i, "SH"):
+		if m.contains(i+1, "HEIM", "HOEK", "HOLM", "HOLZ") {
+			// Germanic
+			m.add("S")
+		} else {
+			m.add("X")
+		}
+		return i + 2
+	case m.contains(i, "SIO", "SIA") || m.contains(i, "SIAN"):
+		// Italian and Armenian
+		if m.slavoGermanic {
+			m.add("S")
+		} else {
+			m.addBoth("S", "X")
+		}
+		return i + 3
+	case i == 0 && m.contains(i+1, "M", "N", "L", "W") || m.contains(i+1, "Z"):
+		// German and anglicised, "smith" matching "schmidt" and "snider"
+		// matching "schneider", and Slavic "sz"
+		m.addBoth("S", "X")
+		if m.contains(i+1, "Z") {
+			return i + 2
+		}
+		return i + 1
+	case m.contains(i, "SC"):
+		switch {
+		case m.at(i+2) == 'H' && m.contains(i+3, "ER", "EN"):
+			// Dutch "schermerhorn", "schenker"
+			m.addBoth("X", "SK")
+		case m.at(i+2) == 'H' && m.contains(i+3, "OO", "UY", "ED", "EM"):
+			// Dutch "school", "schooner"
+			m.add("SK")
+		case m.at(i+2) == 'H' && i == 0 && !m.isVowel(3) && m.at(3) != 'W':
+			// Schlesinger's rule
+			m.addBoth("X", "S")
+		case m.at(i+2) == 'H':
+			m.add("X")
+		case m.contains(i+2, "I", "E", "Y"):
+			m.add("S")
+		default:
+			m.add("SK")
+		}
+		return i + 3
+	}
+
+	if i == len(m.value)-1 && m.contains(i-2, "AI", "OI") {
+		// French "resnais", "artois"
+		m.addBoth("", "S")
+	} else {
+		m.add("S")
+	}
+
+	if m.contains(i+1, "S", "Z") {
+		return i + 2
+	}
+	return i + 1
+}
+
+// Encode a "T" at i.
+func (m *doubleMetaphone) encodeT(i int) int {
+	switch {
+	case m.contains(i, "TION"), m.contains(i, "TIA", "TCH"):
+		m.add("X")
+		return i + 3
+	case m.contains(i, "TH") || m.contains(i, "TTH"):
+		if m.contains(i+2, "OM", "AM") || m.isGermanic() {
+			// "Thomas", "Thames", or Germanic
+			m.add("T")
+		} else {
+			m.addBoth("0", "T")
+		}
+		return i + 2
+	}
+
+	m.add("T")
+
+	if m.contains(i+1, "T", "D") {
+		return i + 2
+	}
+	return i + 1
+}
+
+// Encode a "W" at i.
+func (m *doubleMetaphone) encodeW(i int) int {
+	switch {
+	case m.contains(i, "WR"):
+		m.add("R")
+		return i + 2
+	case i == 0 && m.isVowel(i+1):
+		// "Wasserman" matching "Vasserman"
+		m.addBoth("A", "F")
+	case i == 0 && m.contains(i, "WH"):
+		// "Womo" matching "Uomo"
+		m.add("A")
+	case i == len(m.value)-1 && m.isVowel(i-1) || m.contains(i-1, "EWSKI", "EWSKY", "OWSKI", "OWSKY") || m.contains(0, "SCH"):
+		// "Arnow" matching "Arnoff"
+		m.addBoth("", "F")
+	case m.contains(i, "WICZ", "WITZ"):
+		// Polish "filipowicz"
+		m.addBoth("TS", "FX")
+		return i + 4
+	}
+
+	return i + 1
+}
+
+// Determine if two names sound alike, by comparing their Double Metaphone codes.
+func SoundsLike(a, b string) bool {
+	x1, x2 := DoubleMetaphone(a)
+	y1, y2 := DoubleMetaphone(b)
+
+	if len(x1) == 0 || len(y1) == 0 {
+		return false
+	}
+
+	return x1 == y1 || x1 == y2 || x2 == y1 || x2 == y2
+}
@@ -0,0 +1,128 @@
+package str
+
+import "testing"
+
+func TestSoundex(t *testing.T) {
+
+	check := func(value, expected string) {
+		actual := Soundex(value)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s> for <%s>", expected, actual, value)
+		}
+	}
+
+	check("", "")
+	check("Robert", "R163")
+	check("Rupert", "R163")
+	check("Rubin", "R150")
+	check("Ashcraft", "A261")
+	check("Tymczak", "T522")
+	check("Pfister", "P236")
+	check("Honeyman", "H555")
+	check("O'Brien", "O165")
+	check("Müller", "M460")
+	check("José", "J200")
+	check("123", "")
+}
+
+func TestMetaphone(t *testing.T) {
+
+	check := func(value, expected string) {
+		actual := Metaphone(value)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s> for <%s>", expected, actual, value)
+		}
+	}
+
+	check("", "")
+	check("The", "0")
+	check("quick", "KK")
+	check("brown", "BRN")
+	check("fox", "FKS")
+	check("jumped", "JMPT")
+	check("over", "OFR")
+	check("lazy", "LS")
+	check("dogs", "TKS")
+	check("Knight", "NT")
+	check("Wright", "RT")
+	check("Mitchell", "MXL")
+	check("Thumb", "0M")
+	check("Edge", "EJ")
+	check("Signal", "SNL")
+	check("Xavier", "SFR")
+	check("François", "FRNSS")
+	check("Straße", "STRS")
+}
+
+func TestDoubleMetaphone(t *testing.T) {
+
+	check := func(value, primary, alternate string) {
+		actualPrimary, actualAlternate := DoubleMetaphone(value)
+		if actualPrimary != primary || actualAlternate != alternate {
+			t.Errorf("Expected <%s %s> got <%s %s> for <%s>", primary, alternate, actualPrimary, actualAlternate, value)
+		}
+	}
+
+	check("", "", "")
+	check("Smith", "SM0", "XMT")
+	check("Schmidt", "XMT", "SMT")
+	check("Xavier", "SF", "SFR")
+	check("Catherine", "K0RN", "KTRN")
+	check("Michael", "MKL", "MXL")
+	check("Jankelowicz", "JNKL", "ANKL")
+	check("Arnow", "ARN", "ARNF")
+	check("Wasserman", "ASRM", "FSRM")
+	check("Gallegos", "KLKS", "KKS")
+	check("Czerny", "SRN", "XRN")
+	check("Bacci", "PX", "PX")
+	check("Caesar", "SSR", "SSR")
+	check("Chianti", "KNT", "KNT")
+	check("Laugh", "LF", "LF")
+	check("Hugh", "H", "H")
+	check("Tagliaro", "TKLR", "TLR")
+	check("Breaux", "PR", "PR")
+	check("Jose", "HS", "HS")
+	check("José", "HS", "HS")
+	check("Nuñez", "NNS", "NNS")
+	check("Müller", "MLR", "MLR")
+}
+
+func TestNYSIIS(t *testing.T) {
+
+	check := func(value, expected string) {
+		actual := NYSIIS(value)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s> for <%s>", expected, actual, value)
+		}
+	}
+
+	check("", "")
+	check("Knight", "NAGT")
+	check("Mitchell", "MATCAL")
+	check("Worthy", "WARTY")
+	check("Ogata", "OGAT")
+	check("Bishop", "BASAP")
+	check("Brian", "BRAN")
+	check("Macintosh", "MCANT")
+	check("Schmidt", "SNAD")
+	check("Søren", "SARAN")
+}
+
+func TestSoundsLike(t *testing.T) {
+
+	check := func(a, b string, expected bool) {
+		if actual := SoundsLike(a, b); actual != expected {
+			t.Errorf("Expected <%t> got <%t> for <%s> <%s>", expected, actual, a, b)
+		}
+	}
+
+	check("Smith", "Schmidt", true)
+	check("Catherine", "Kathryn", true)
+	check("Wasserman", "Vasserman", true)
+	check("Müller", "Mueller", true)
+	check("José", "Jose", true)
+	check("Zoë", "Zoe", true)
+	check("Smith", "Jones", false)
+	check("", "", false)
+	check("Smith", "", false)
+}
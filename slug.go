@@ -0,0 +1,132 @@
+package str
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SlugOptions configures the slug generated by SlugWith.
+type SlugOptions struct {
+	// Separator placed between words, "-" when empty.
+	Separator string
+
+	// Language tag used to transliterate the value to ASCII, such as "de".
+	Language string
+
+	// Replacements applied in a single pass before transliteration, merged
+	// over the defaults "@" => "at" and "&" => "and". Where keys overlap the
+	// longest one wins.
+	Dictionary map[string]string
+
+	// Maximum length of the slug, truncated on a word boundary. Zero means no limit.
+	MaxLength int
+
+	// Keep the case of the value instead of lower-casing it.
+	PreserveCase bool
+
+	// Called to check whether a slug is already taken; "-2", "-3" and so on
+	// are appended until it returns false. The slug is shortened to fit the
+	// suffix within MaxLength, but always keeps its first character, so a
+	// MaxLength too short for that and the suffix is exceeded, as in "h-10".
+	Exists func(slug string) bool
+}
+
+// Generate a URL friendly "slug" from a given string using the given options.
+func SlugWith(value string, opts SlugOptions) string {
+	separator := opts.Separator
+	if len(separator) == 0 {
+		separator = "-"
+	}
+
+	replacements := map[string]string{
+		"@": "at",
+		"&": "and",
+	}
+
+	for k, v := range opts.Dictionary {
+		replacements[k] = v
+	}
+
+	pairs := make([]string, 0, len(replacements)*2)
+	for _, k := range sortedReplacementKeys(replacements) {
+		pairs = append(pairs, k, " "+replacements[k]+" ")
+	}
+	value = strings.NewReplacer(pairs...).Replace(value)
+
+	value = Ascii(value, opts.Language)
+
+	if !opts.PreserveCase {
+		value = Lower(value)
+	}
+
+	words := strings.FieldsFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+
+	slug := joinSlugWords(words, separator, opts.MaxLength)
+
+	if opts.Exists == nil {
+		return slug
+	}
+
+	candidate := slug
+	for n := 2; opts.Exists(candidate); n++ {
+		suffix := strconv.Itoa(n)
+		if len(slug) > 0 {
+			suffix = separator + suffix
+		}
+		limit := opts.MaxLength
+		if limit > 0 {
+			limit = max(limit-Length(suffix), 1)
+		}
+		candidate = joinSlugWords(words, separator, limit) + suffix
+	}
+
+	return candidate
+}
+
+// Sort replacement keys longest first, then alphabetically, so overlapping
+// keys are always replaced in the same order.
+func sortedReplacementKeys(replacements map[string]string) []string {
+	keys := make([]string, 0, len(replacements))
+	for k := range replacements {
+		if len(k) > 0 {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	return keys
+}
+
+// Join words with a separator, keeping as many whole words as fit within limit.
+// A first word longer than limit is cut. A limit of zero or less means no limit.
+func joinSlugWords(words []string, separator string, limit int) string {
+	slug := strings.Join(words, separator)
+
+	if limit <= 0 || Length(slug) <= limit {
+		return slug
+	}
+
+	result := words[0]
+	if Length(result) > limit {
+		return Substr(result, 0, limit)
+	}
+
+	for _, word := range words[1:] {
+		next := result + separator + word
+		if Length(next) > limit {
+			break
+		}
+		result = next
+	}
+
+	return result
+}
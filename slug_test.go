@@ -0,0 +1,52 @@
+package str
+
+import "testing"
+
+func TestSlugWith(t *testing.T) {
+
+	check := func(value string, opts SlugOptions, expected string) {
+		actual := SlugWith(value, opts)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("Hello World", SlugOptions{}, "hello-world")
+	check("Hello World", SlugOptions{Separator: "_"}, "hello_world")
+	check("Hello World", SlugOptions{PreserveCase: true}, "Hello-World")
+	check("user@host & co", SlugOptions{}, "user-at-host-and-co")
+	check("Crème brûlée", SlugOptions{}, "creme-brulee")
+	check("Müller Straße", SlugOptions{Language: "de"}, "mueller-strasse")
+	check("Müller Straße", SlugOptions{}, "muller-strasse")
+	check("", SlugOptions{}, "")
+	check("!!!", SlugOptions{}, "")
+
+	// dictionary replacements are applied longest key first
+	dictionary := map[string]string{"c": "see", "c++": "cpp", "c#": "csharp"}
+	for i := 0; i < 20; i++ {
+		check("c++ and c# and c", SlugOptions{Dictionary: dictionary}, "cpp-and-csharp-and-see")
+	}
+	check("user@host", SlugOptions{Dictionary: map[string]string{"@": "at-sign"}}, "user-at-sign-host")
+
+	// truncation on word boundaries
+	check("the quick brown fox", SlugOptions{MaxLength: 15}, "the-quick-brown")
+	check("the quick brown fox", SlugOptions{MaxLength: 14}, "the-quick")
+	check("the quick brown fox", SlugOptions{MaxLength: 100}, "the-quick-brown-fox")
+	check("internationalization", SlugOptions{MaxLength: 5}, "inter")
+
+	// uniqueness
+	taken := map[string]bool{"hello-world": true, "hello-world-2": true}
+	exists := func(slug string) bool {
+		return taken[slug]
+	}
+
+	check("Hello World", SlugOptions{Exists: exists}, "hello-world-3")
+	check("Hello There", SlugOptions{Exists: exists}, "hello-there")
+	check("Hello World", SlugOptions{Exists: exists, Separator: "_"}, "hello_world")
+	check("Hello World Again", SlugOptions{Exists: exists, MaxLength: 13}, "hello-world-3")
+
+	// the suffix is kept whole when MaxLength is too short for it
+	full := func(slug string) bool { return Length(slug) == 3 }
+	check("Hello", SlugOptions{Exists: full, MaxLength: 3}, "h-10")
+	check("", SlugOptions{Exists: func(slug string) bool { return slug == "" }}, "2")
+}
@@ -1,20 +1,45 @@
+// Package str provides string manipulation functions extending the standard
+// strings package.
+//
+// Most functions cannot fail. The exceptions are:
+//
+//   - Match and MatchAll panic on an invalid regular expression; MatchE and
+//     MatchAllE return the error instead. MatchGroups, MatchAllGroups and
+//     ReplaceMatches panic on an invalid regular expression too.
+//   - Is treats an invalid pattern as not matching; IsE returns the error.
+//     IsMatch treats an invalid regular expression as not matching.
+//   - Password and Random panic if the system's secure random source fails or
+//     the length is negative; PasswordE and RandomE return the error instead.
+//     UUID, UUIDv7 and ULID panic if the secure random source fails.
+//   - UUIDv5 returns an error for an invalid namespace, ParseUUID for an
+//     invalid UUID and ParseULID for an invalid ULID.
+//   - Inflector.AddPluralRule and Inflector.AddSingularRule panic on an
+//     invalid regular expression.
+//   - Hamming returns -1 for strings of different lengths.
 package str
 
 import (
 	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"math"
 	"math/big"
 	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
+	"unicode"
 	"unicode/utf8"
-
-	"github.com/chr15k/go-strings/internal/utils"
 )
 
+// Pattern of the characters removed by Numbers.
+var nonDigits = regexp.MustCompile(`[^0-9]`)
+
+// Secure random source used by the generating functions.
+var randomSource io.Reader = rand.Reader
+
 // Return the remainder of a string after the first occurrence of a given value.
 func After(subject, search string) string {
 	if len(search) == 0 {
@@ -92,7 +117,18 @@ func BetweenFirst(subject, from, to string) string {
 
 // Convert a value to camel case.
 func Camel(value string) string {
-	return Lcfirst(Studly(value))
+	return CamelWith(value, nil)
+}
+
+// Convert a value to camel case, with per-call initialism overrides.
+func CamelWith(value string, overrides Initialisms) string {
+	words := splitWords(value, overrides)
+
+	if len(words) == 0 {
+		return ""
+	}
+
+	return Lower(words[0]) + StudlyWith(strings.Join(words[1:], " "), overrides)
 }
 
 // Determine if a given string contains a given substring.
@@ -126,42 +162,173 @@ func EndsWith(haystack string, needles interface{}) bool {
 	return false
 }
 
+// Extract an excerpt from a string around the first case-insensitive match of a phrase.
+//
+// Up to radius user-perceived characters are kept on each side of the match,
+// and the omission is added to each side that was shortened. A word cut by the
+// radius is dropped, unless that would leave nothing of that side. An empty
+// string is returned when the phrase is not found.
+func Excerpt(value, phrase string, radius int, omission string) string {
+	loc := indexFold(value, phrase)
+
+	if loc == nil {
+		return ""
+	}
+
+	radius = max(radius, 0)
+
+	start := strings.TrimLeftFunc(value[:loc[0]], unicode.IsSpace)
+	clusters := Graphemes(start)
+
+	if len(clusters) > radius {
+		kept := clusters[len(clusters)-radius:]
+		if isWordCluster(clusters[len(clusters)-radius-1]) {
+			for i, cluster := range kept {
+				if !isWordCluster(cluster) {
+					kept = kept[i:]
+					break
+				}
+			}
+		}
+		start = omission + strings.TrimLeftFunc(strings.Join(kept, ""), unicode.IsSpace)
+	}
+
+	end := strings.TrimRightFunc(value[loc[1]:], unicode.IsSpace)
+	clusters = Graphemes(end)
+
+	if len(clusters) > radius {
+		kept := clusters[:radius]
+		if isWordCluster(clusters[radius]) {
+			for i := len(kept) - 1; i >= 0; i-- {
+				if !isWordCluster(kept[i]) {
+					kept = kept[:i+1]
+					break
+				}
+			}
+		}
+		end = strings.TrimRightFunc(strings.Join(kept, ""), unicode.IsSpace) + omission
+	}
+
+	return start + value[loc[0]:loc[1]] + end
+}
+
+// Find the first occurrence of substr in s under simple Unicode case folding,
+// as a case-insensitive regexp would, returning its start and end offsets.
+func indexFold(s, substr string) []int {
+	if substr == "" {
+		return []int{0, 0}
+	}
+
+	for i := range s {
+		if n, ok := hasPrefixFold(s[i:], substr); ok {
+			return []int{i, i + n}
+		}
+	}
+
+	return nil
+}
+
+// Determine if s starts with prefix under simple Unicode case folding, getting
+// the length of the matching part of s.
+func hasPrefixFold(s, prefix string) (int, bool) {
+	n := 0
+	for _, r := range prefix {
+		if n >= len(s) {
+			return 0, false
+		}
+
+		c, size := utf8.DecodeRuneInString(s[n:])
+		if !equalFoldRune(c, r) {
+			return 0, false
+		}
+		n += size
+	}
+
+	return n, true
+}
+
+// Determine if two runes are equal under simple Unicode case folding.
+func equalFoldRune(a, b rune) bool {
+	if a == b {
+		return true
+	}
+
+	for f := unicode.SimpleFold(a); f != a; f = unicode.SimpleFold(f) {
+		if f == b {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Determine if a user-perceived character is part of a word.
+func isWordCluster(cluster string) bool {
+	r, _ := utf8.DecodeRuneInString(cluster)
+	return isWordRune(r)
+}
+
 // Adds a single instance of the given value to a string if it does not already end with that value:
 func Finish(value, cap string) string {
-	quoted := regexp.QuoteMeta(cap)
-
-	re := regexp.MustCompile(fmt.Sprintf(`(?:%s)+$`, quoted))
+	if len(cap) > 0 {
+		for strings.HasSuffix(value, cap) {
+			value = value[:len(value)-len(cap)]
+		}
+	}
 
-	return fmt.Sprintf("%s%s", re.ReplaceAllString(value, ""), cap)
+	return value + cap
 }
 
 // Determine if a given string matches a given pattern.
+//
+// Patterns given as anything but a string or []string, and invalid patterns,
+// do not match. Use IsE to detect them.
 func Is(patterns interface{}, value string) bool {
+	matched, _ := is(patterns, value, false)
+	return matched
+}
+
+// Determine if a given string matches a given pattern, returning an error for
+// patterns given as anything but a string or []string, or for the first
+// invalid pattern that is reached.
+func IsE(patterns interface{}, value string) (bool, error) {
+	return is(patterns, value, true)
+}
+
+// Match a value against wildcard patterns, stopping at an invalid one when strict.
+func is(patterns interface{}, value string, strict bool) (bool, error) {
 
 	switch patterns.(type) {
 	case []string:
 	case string:
 		patterns = []string{patterns.(string)}
 	default:
-		return false
+		return false, fmt.Errorf("str: patterns must be a string or []string, got %T", patterns)
 	}
 
 	if len(patterns.([]string)) == 0 && len(value) == 0 {
-		return true
+		return true, nil
 	}
 
 	for _, pattern := range patterns.([]string) {
 		if pattern == value {
-			return true
+			return true, nil
 		}
 
-		matched, _ := regexp.MatchString(utils.WildCardToRegexp(pattern), value)
-		if matched {
-			return true
+		re, err := compileWildcard(pattern)
+		if err != nil {
+			if strict {
+				return false, err
+			}
+			continue
+		}
+
+		if re.MatchString(value) {
+			return true, nil
 		}
 	}
 
-	return false
+	return false, nil
 }
 
 // Determine if a given value is valid JSON.
@@ -175,32 +342,14 @@ func IsUrl(value string) bool {
 	return err == nil && url.Scheme != "" && url.Host != ""
 }
 
-// Determine if a given value is a valid UUID.
-func IsUUID(value string) bool {
-	re := regexp.MustCompile(`^[\da-fA-F]{8}-[\da-fA-F]{4}-[\da-fA-F]{4}-[\da-fA-F]{4}-[\da-fA-F]{12}$`)
-	return len(re.FindString(value)) > 0
-}
-
-// Determine if a given value is a valid ULID.
-func IsULID(value string) bool {
-	re := regexp.MustCompile(`^[a-zA-Z0-9]{26}$`)
-	if re.MatchString(value) {
-		n, _ := strconv.Atoi(string(value[0]))
-		return n <= 7
-	}
-	return false
-}
-
 // Convert a string to kebab case.
 func Kebab(value string) string {
-	matchAllCap := regexp.MustCompile("([a-z0-9])([A-Z])")
-
-	value = matchAllCap.ReplaceAllString(value, "${1}-${2}")
-
-	value = strings.ReplaceAll(value, "_", "-")
-	value = strings.ReplaceAll(value, " ", "-")
+	return KebabWith(value, nil)
+}
 
-	return Lower(value)
+// Convert a string to kebab case, with per-call initialism overrides.
+func KebabWith(value string, overrides Initialisms) string {
+	return Lower(strings.Join(splitWords(value, overrides), "-"))
 }
 
 // Get the length of a given string.
@@ -228,20 +377,25 @@ func Lower(value string) string {
 
 // Masks a portion of a string with a repeated character.
 func Mask(value, character string, index, length int) string {
+	return mask(value, character, index, length, Substr, Length)
+}
+
+// Mask a portion of a string, measuring it with the given substr and length functions.
+func mask(value, character string, index, length int, substr func(string, int, int) string, count func(string) int) string {
 
 	if len(character) == 0 {
 		return value
 	}
 
-	segment := Substr(value, index, length)
-	segmentLen := Length(segment)
+	segment := substr(value, index, length)
+	segmentLen := count(segment)
 
 	if segmentLen == 0 {
 		return value
 	}
 
-	char := Substr(character, 0, 1)
-	valueLen := Length(value)
+	char := substr(character, 0, 1)
+	valueLen := count(value)
 	startIndex := index
 
 	if valueLen+index <= 0 {
@@ -256,28 +410,136 @@ func Mask(value, character string, index, length int) string {
 		}
 	}
 
-	start := Substr(value, 0, startIndex)
-	end := Substr(value, startIndex+segmentLen, valueLen)
+	start := substr(value, 0, startIndex)
+	end := substr(value, startIndex+segmentLen, valueLen)
 
 	return fmt.Sprintf("%s%s%s", start, strings.Repeat(char, segmentLen), end)
 }
 
 // Get the string matching the given pattern.
+// Panics if the pattern is invalid; use MatchE for untrusted patterns.
 func Match(pattern, value string) string {
-	re := regexp.MustCompile(pattern)
-	return re.FindString(value)
+	return mustCompilePattern(pattern).FindString(value)
+}
+
+// Get the string matching the given pattern, or an error if the pattern is invalid.
+func MatchE(pattern, value string) (string, error) {
+	re, err := compilePattern(pattern)
+	if err != nil {
+		return "", err
+	}
+	return re.FindString(value), nil
 }
 
 // Get the string matching the given pattern.
+// Panics if the pattern is invalid; use MatchAllE for untrusted patterns.
 func MatchAll(pattern, value string) []string {
-	re := regexp.MustCompile(pattern)
-	return re.FindAllString(value, -1)
+	return mustCompilePattern(pattern).FindAllString(value, -1)
+}
+
+// Get every string matching the given pattern, or an error if the pattern is invalid.
+func MatchAllE(pattern, value string) ([]string, error) {
+	re, err := compilePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return re.FindAllString(value, -1), nil
+}
+
+// Get the capture groups of the first match of the given pattern, or nil when
+// nothing matches.
+//
+// Groups are keyed by number, "0" being the whole match, and named groups by
+// name too. Groups that did not take part in the match are empty.
+// Panics if the pattern is invalid.
+func MatchGroups(pattern, value string) map[string]string {
+	re := mustCompilePattern(pattern)
+
+	loc := re.FindStringSubmatchIndex(value)
+	if loc == nil {
+		return nil
+	}
+
+	return matchGroups(re, value, loc)
+}
+
+// Get the capture groups of every match of the given pattern. See MatchGroups.
+// Panics if the pattern is invalid.
+func MatchAllGroups(pattern, value string) []map[string]string {
+	re := mustCompilePattern(pattern)
+	matches := make([]map[string]string, 0)
+
+	for _, loc := range re.FindAllStringSubmatchIndex(value, -1) {
+		matches = append(matches, matchGroups(re, value, loc))
+	}
+
+	return matches
+}
+
+// Replace every match of the given pattern with the result of calling replace
+// with the capture groups of the match. See MatchGroups.
+// Panics if the pattern is invalid.
+func ReplaceMatches(pattern, value string, replace func(groups map[string]string) string) string {
+	re := mustCompilePattern(pattern)
+
+	var builder strings.Builder
+	last := 0
+
+	for _, loc := range re.FindAllStringSubmatchIndex(value, -1) {
+		builder.WriteString(value[last:loc[0]])
+		builder.WriteString(replace(matchGroups(re, value, loc)))
+		last = loc[1]
+	}
+
+	builder.WriteString(value[last:])
+
+	return builder.String()
+}
+
+// Get the capture groups of a match, keyed by number and by name.
+func matchGroups(re *regexp.Regexp, value string, loc []int) map[string]string {
+	names := re.SubexpNames()
+	groups := make(map[string]string, len(names))
+
+	for i, name := range names {
+		group := ""
+		if loc[2*i] >= 0 {
+			group = value[loc[2*i]:loc[2*i+1]]
+		}
+
+		groups[strconv.Itoa(i)] = group
+		if len(name) > 0 && (len(group) > 0 || len(groups[name]) == 0) {
+			groups[name] = group
+		}
+	}
+
+	return groups
+}
+
+// Determine if a given string matches a regular expression, or any of the
+// given slice of regular expressions. Invalid regular expressions do not match.
+func IsMatch(patterns interface{}, value string) bool {
+
+	switch patterns.(type) {
+	case []string:
+	case string:
+		patterns = []string{patterns.(string)}
+	default:
+		return false
+	}
+
+	for _, pattern := range patterns.([]string) {
+		if re, err := compilePattern(pattern); err == nil && re.MatchString(value) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // Remove all non-numeric characters from a string.
 func Numbers(value string) string {
-	re := regexp.MustCompile(`[^0-9]`)
-	return re.ReplaceAllString(value, "")
+	return nonDigits.ReplaceAllString(value, "")
 }
 
 // Pad both sides of a string with another.
@@ -312,7 +574,17 @@ func PadRight(value string, length int, pad string) string {
 }
 
 // Generate a random, secure password.
+// Panics if the secure random source fails; use PasswordE to handle the error.
 func Password(length int, includeNumbers bool, includeSpecial bool) string {
+	password, err := PasswordE(length, includeNumbers, includeSpecial)
+	if err != nil {
+		panic(err)
+	}
+	return password
+}
+
+// Generate a random, secure password, or return the error of the secure random source.
+func PasswordE(length int, includeNumbers bool, includeSpecial bool) (string, error) {
 	charset := "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 
 	if includeNumbers {
@@ -323,67 +595,68 @@ func Password(length int, includeNumbers bool, includeSpecial bool) string {
 		charset += "!@#$%^&*()_+"
 	}
 
-	password := make([]byte, length)
-	charsetLength := big.NewInt(int64(len(charset)))
-
-	for i := range password {
-		index, _ := rand.Int(rand.Reader, charsetLength)
-		password[i] = charset[index.Int64()]
-	}
-
-	return string(password)
+	return random(charset, length)
 }
 
 // Generate a random alpha-numeric string.
+// Panics if the secure random source fails; use RandomE to handle the error.
 func Random(length int) string {
+	str, err := RandomE(length)
+	if err != nil {
+		panic(err)
+	}
+	return str
+}
 
-	const charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+// Generate a random alpha-numeric string, or return the error of the secure random source.
+func RandomE(length int) (string, error) {
+	return random("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789", length)
+}
+
+// Generate a string of characters drawn uniformly from a charset.
+func random(charset string, length int) (string, error) {
+	if length < 0 {
+		return "", errors.New("str: negative length")
+	}
 
 	str := make([]byte, length)
 	charsetLength := big.NewInt(int64(len(charset)))
 
 	for i := range str {
-		index, _ := rand.Int(rand.Reader, charsetLength)
+		index, err := rand.Int(randomSource, charsetLength)
+		if err != nil {
+			return "", err
+		}
 		str[i] = charset[index.Int64()]
 	}
 
-	return string(str)
+	return string(str), nil
 }
 
-// Generate a URL friendly "slug" from a given string.
-func Slug(value string, overrides map[string]string) string {
-
-	replacements := make(map[string]string)
-	replacements["@"] = "-at-"
-	replacements["&"] = "-and-"
+// Reverse the characters of a given string.
+func Reverse(value string) string {
+	runes := []rune(value)
 
-	for k, v := range overrides {
-		replacements[k] = v
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
 	}
 
-	for k, v := range replacements {
-		value = strings.ReplaceAll(value, k, v)
-	}
-
-	re, _ := regexp.Compile("[^a-zA-Z0-9]+")
-	value = re.ReplaceAllString(value, " ")
-
-	value = strings.TrimSpace(value)
-	value = strings.ReplaceAll(value, " ", "-")
+	return string(runes)
+}
 
-	return Lower(value)
+// Generate a URL friendly "slug" from a given string.
+func Slug(value string, overrides map[string]string) string {
+	return SlugWith(value, SlugOptions{Dictionary: overrides})
 }
 
 // Convert a string to snake case.
 func Snake(value string) string {
-	matchAllCap := regexp.MustCompile("([a-z0-9])([A-Z])")
-
-	value = matchAllCap.ReplaceAllString(value, "${1}-${2}")
-
-	value = strings.ReplaceAll(value, "-", "_")
-	value = strings.ReplaceAll(value, " ", "_")
+	return SnakeWith(value, nil)
+}
 
-	return Lower(value)
+// Convert a string to snake case, with per-call initialism overrides.
+func SnakeWith(value string, overrides Initialisms) string {
+	return Lower(strings.Join(splitWords(value, overrides), "_"))
 }
 
 // Determine if a given string starts with a given substring.
@@ -407,15 +680,21 @@ func StartsWith(haystack string, needles interface{}) bool {
 
 // Convert a value to studly case.
 func Studly(value string) string {
+	return StudlyWith(value, nil)
+}
 
-	value = strings.Replace(value, "-", " ", -1)
-	value = strings.Replace(value, "_", " ", -1)
+// Convert a value to studly case, with per-call initialism overrides.
+func StudlyWith(value string, overrides Initialisms) string {
 
-	split := strings.Split(value, " ")
+	words := splitWords(value, overrides)
 
-	output := make([]string, 0, len(split))
-	for _, val := range split {
-		output = append(output, Ucfirst(val))
+	output := make([]string, 0, len(words))
+	for _, word := range words {
+		if overrides.has(word) {
+			output = append(output, Upper(word))
+		} else {
+			output = append(output, Ucfirst(word))
+		}
 	}
 
 	return strings.Join(output, "")
@@ -435,43 +714,50 @@ func Squish(value string) string {
 // * If start or length is invalid, an empty string will be returned.
 func Substr(str string, start, length int) string {
 
-	if length == 0 {
+	runeStr := []rune(str)
+
+	from, to, ok := substrRange(len(runeStr), start, length)
+	if !ok {
 		return ""
 	}
 
-	runeStr := []rune(str)
-	runeLen := len(runeStr)
+	return string(runeStr[from:to])
+}
 
-	if runeLen == 0 {
-		return ""
+// Resolve the start and length parameters of Substr against a sequence of the
+// given size, returning the bounds of the portion and whether it is non-empty.
+func substrRange(size, start, length int) (int, int, bool) {
+
+	if length == 0 || size == 0 {
+		return 0, 0, false
 	}
 
 	if start < 0 {
-		start = runeLen + start
+		start = size + start
 	}
 	if start < 0 {
 		start = 0
 	}
-	if start > runeLen-1 {
-		return ""
+	if start > size-1 {
+		return 0, 0, false
 	}
 
-	end := runeLen
+	end := size
 
 	if length < 0 {
-		end = runeLen + length
+		end = size + length
 	} else if length > 0 {
 		end = start + length
 	}
 
 	if end < 0 || start >= end {
-		return ""
+		return 0, 0, false
 	}
-	if end > runeLen {
-		end = runeLen
+	if end > size {
+		end = size
 	}
 
-	return string(runeStr[start:end])
+	return start, end, true
 }
 
 // Take the first or last {limit} characters of a string.
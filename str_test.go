@@ -1,7 +1,8 @@
 package str
 
 import (
-	"fmt"
+	"crypto/rand"
+	"errors"
 	"reflect"
 	"regexp"
 	"testing"
@@ -172,6 +173,14 @@ func TestCamel(t *testing.T) {
 	check("", "")
 	check("foo1_bar", "foo1Bar")
 	check("1 foo bar", "1FooBar")
+	check("HTTPServer", "httpServer")
+	check("user_id", "userID")
+	check("url_parser", "urlParser")
+	check("foo.bar/baz", "fooBarBaz")
+	check("żółta_łódka", "żółtaŁódka")
+	check("URLs", "urls")
+	check("userIDs", "userIDs")
+	check("ListAPIs", "listAPIs")
 }
 
 func TestEndsWith(t *testing.T) {
@@ -205,6 +214,44 @@ func TestEndsWith(t *testing.T) {
 	check("你好", "a", false)
 }
 
+func TestExcerpt(t *testing.T) {
+
+	check := func(value, phrase string, radius int, omission, expected string) {
+		actual := Excerpt(value, phrase, radius, omission)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("This is my name", "my", 3, "...", "...is my...")
+	check("This is my name", "name", 3, "(...) ", "(...) my name")
+	check("This is my name", "this", 3, "...", "This is...")
+	check("This is my name", "MY", 100, "...", "This is my name")
+	check("This is my name", "my", 0, "...", "...my...")
+	check("This is my name", "my", 3, "", "is my")
+	check("This is my name", "", 4, "...", "This...")
+	check("This is my name", "nope", 3, "...", "")
+	check("", "", 3, "...", "")
+	check("  This is my name  ", "is my", 5, "...", "This is my name")
+
+	// words cut by the radius are dropped
+	check("The quick brown fox jumps over the lazy dog", "fox", 8, "...", "...brown fox jumps...")
+	check("The quick brown fox jumps over the lazy dog", "fox", 13, "...", "...quick brown fox jumps over...")
+	check("Supercalifragilistic fox", "fox", 5, "...", "...fox")
+	check("Supercalifragilistic", "fragil", 3, "...", "...alifragilist...")
+
+	// regular expression characters are matched literally
+	check("Does 1+1=2? Yes.", "1+1", 5, "...", "Does 1+1=2?...")
+
+	// multibyte and case-insensitive
+	check("Łódź jest dużym MIASTEM w Polsce", "miastem", 6, "…", "…dużym MIASTEM w…")
+	check("这是一段很长的中文文本", "中文", 2, "...", "...长的中文文本")
+	check("Crème brûlée et café", "BRÛLÉE", 6, "...", "Crème brûlée et...")
+	check("naïve café culture", "culture", 5, "...", "...café culture")
+	check("ÉTÉ À MONTRÉAL", "à montréal", 4, "...", "ÉTÉ À MONTRÉAL")
+	check("Temperature: 300 \u212a", "k", 4, "...", "...300 \u212a")
+}
+
 func TestFinish(t *testing.T) {
 
 	check := func(value, cap, expected string) {
@@ -224,6 +271,9 @@ func TestFinish(t *testing.T) {
 	check("12345678", "1234", "123456781234")
 	check("chris", " keller", "chris keller")
 	check("chris", "chris", "chris")
+	check("aaa", "aa", "aaa")
+	check("a.b", ".b", "a.b")
+	check("chris", "", "chris")
 }
 
 func TestIs(t *testing.T) {
@@ -258,6 +308,30 @@ func TestIs(t *testing.T) {
 	// empty patterns
 	check([]string{}, "test", false)
 	check([]string{}, "", true)
+
+	// invalid patterns do not match
+	check("a(", "a(", true)
+	check([]string{"a(", "b*"}, "bar", true)
+	check([]string{"a(", "b*"}, "foo", false)
+	check(42, "42", false)
+}
+
+func TestIsE(t *testing.T) {
+	check := func(patterns interface{}, value string, expected, fails bool) {
+		actual, err := IsE(patterns, value)
+		if actual != expected || (err != nil) != fails {
+			t.Errorf("Expected <%t %t> got <%t %v>", expected, fails, actual, err)
+		}
+	}
+
+	check("foo/*", "foo/bar/baz", true, false)
+	check("*BAZ*", "foo/bar/baz", false, false)
+	check([]string{}, "", true, false)
+	check("a(", "a(", true, false)
+	check("a(", "abc", false, true)
+	check([]string{"b*", "a("}, "bar", true, false)
+	check([]string{"a(", "b*"}, "bar", false, true)
+	check(42, "42", false, true)
 }
 
 func TestIsJSON(t *testing.T) {
@@ -296,49 +370,6 @@ func TestIsUrl(t *testing.T) {
 	check(`invalid url`, false)
 }
 
-func TestIsUUID(t *testing.T) {
-
-	check := func(value string, expected bool) {
-		actual := IsUUID(value)
-		if actual != expected {
-			t.Errorf("Expected <%t> got <%t>", expected, actual)
-		}
-	}
-
-	check(`fd2ac93c-6783-4fa9-830f-a32dff18fb0b`, true)
-	check(`71ebdc83-9df4-4409-ad61-00d53707a9a3`, true)
-	check(`d7115ae3-4291-4901-b5f7-343da54d8146`, true)
-	check(`03a88d85-552a-4242-8193-85fb963ba529`, true)
-	check(`b69ffe23-5a89-4d6f-99b5-65515b52c212`, true)
-	check(`713d996e-e1db-4c7d-b94a-0aa1dd1b4be7`, true)
-	check(`b487f049-2777-41cc-8164-0ed6ec30f6db`, true)
-	check(`ec3feb6d-e116-48a8-a237-0dc0724821ed`, true)
-	check(`3225d006-e67c-48d1-b4ca-acce30baca43`, true)
-	check(`283d2f17-43f6-4c6d-939f-4f95ffd5b3f0`, true)
-
-	check(`fd2ac93c-6783-830f-a32dff18fb0b`, false)
-	check(`71e-9df4-4409-ad61-00d53707a9a3`, false)
-	check(`d7115ae3-4291-4901-b5f7146`, false)
-	check(`-85fb963ba529`, false)
-	check(`12345`, false)
-	check(`713d996ee1db4c7db94a-0aa1dd1b4be7`, false)
-
-}
-
-func TestIsULID(t *testing.T) {
-
-	check := func(value string, expected bool) {
-		actual := IsULID(value)
-		if actual != expected {
-			fmt.Println(value)
-			t.Errorf("Expected <%t> got <%t>", expected, actual)
-		}
-	}
-
-	check(`01GJSNW9MAF792C0XYY8RX6QFT`, true)
-	check(`01GJSNW9MAF-792C0XYY8RX6ssssss-QFT`, false)
-}
-
 func TestSnake(t *testing.T) {
 	check := func(value, expected string) {
 		actual := Snake(value)
@@ -354,7 +385,17 @@ func TestSnake(t *testing.T) {
 	check("Golang-string-helpers", "golang_string_helpers")
 	check("Foo-Bar", "foo_bar")
 	check("Foo_Bar", "foo_bar")
-	check("ŻółtaŁódka", "żółtałódka")
+	check("ŻółtaŁódka", "żółta_łódka")
+	check("HTTPServerID", "http_server_id")
+	check("HTTPURLParser", "http_url_parser")
+	check("userID2FA", "user_id2_fa")
+	check("foo.bar", "foo_bar")
+	check("foo/bar-baz_qux", "foo_bar_baz_qux")
+	check("foo1Bar", "foo1_bar")
+	check("userIDs", "user_ids")
+	check("URLs", "urls")
+	check("ListAPIs", "list_apis")
+	check("", "")
 }
 
 func TestKebab(t *testing.T) {
@@ -372,7 +413,14 @@ func TestKebab(t *testing.T) {
 	check("Golang-string-helpers", "golang-string-helpers")
 	check("Foo-Bar", "foo-bar")
 	check("Foo_Bar", "foo-bar")
-	check("ŻółtaŁódka", "żółtałódka")
+	check("ŻółtaŁódka", "żółta-łódka")
+	check("HTTPServerID", "http-server-id")
+	check("JSONAPIClient", "json-api-client")
+	check("foo.bar", "foo-bar")
+	check("foo / bar", "foo-bar")
+	check("ListAPIs", "list-apis")
+	check("userIDsByURLs", "user-ids-by-urls")
+	check("", "")
 }
 
 func TestLcfirst(t *testing.T) {
@@ -498,6 +546,28 @@ func TestMatch(t *testing.T) {
 
 	check(`foo.?`, "seafood fool", "food")
 	check(`foo.?`, "meat", "")
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected Match to panic on an invalid pattern")
+		}
+	}()
+	Match(`foo(`, "food")
+}
+
+func TestMatchE(t *testing.T) {
+
+	check := func(pattern, value, expected string, fails bool) {
+		actual, err := MatchE(pattern, value)
+		if actual != expected || (err != nil) != fails {
+			t.Errorf("Expected <%s %t> got <%s %v>", expected, fails, actual, err)
+		}
+	}
+
+	check(`foo.?`, "seafood fool", "food", false)
+	check(`foo.?`, "meat", "", false)
+	check(`foo(`, "food", "", true)
+	check(`[z-a]`, "food", "", true)
 }
 
 func TestMatchAll(t *testing.T) {
@@ -520,6 +590,101 @@ func TestMatchAll(t *testing.T) {
 	check(`a.`, "none", []string{})
 }
 
+func TestMatchAllE(t *testing.T) {
+
+	check := func(pattern, value string, expected []string, fails bool) {
+		actual, err := MatchAllE(pattern, value)
+		if (err != nil) != fails || len(actual)+len(expected) > 0 && !reflect.DeepEqual(actual, expected) {
+			t.Errorf("Expected <%+v %t> got <%+v %v>", expected, fails, actual, err)
+		}
+	}
+
+	check(`a.`, "paranormal", []string{"ar", "an", "al"}, false)
+	check(`a.`, "none", []string{}, false)
+	check(`a(`, "paranormal", nil, true)
+}
+
+func TestMatchGroups(t *testing.T) {
+
+	check := func(pattern, value string, expected map[string]string) {
+		actual := MatchGroups(pattern, value)
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("Expected <%+v> got <%+v>", expected, actual)
+		}
+	}
+
+	check(`(?P<year>\d{4})-(?P<month>\d{2})`, "on 2024-03 and 2025-04", map[string]string{
+		"0": "2024-03", "1": "2024", "2": "03", "year": "2024", "month": "03",
+	})
+	check(`(\w+)@(\w+)`, "mail chris@example", map[string]string{
+		"0": "chris@example", "1": "chris", "2": "example",
+	})
+	check(`(?P<sign>-)?(?P<digits>\d+)`, "x42", map[string]string{
+		"0": "42", "1": "", "2": "42", "sign": "", "digits": "42",
+	})
+	check(`foo.?`, "seafood", map[string]string{"0": "food"})
+	check(`(?P<year>\d{4})`, "no digits", nil)
+}
+
+func TestMatchAllGroups(t *testing.T) {
+
+	check := func(pattern, value string, expected []map[string]string) {
+		actual := MatchAllGroups(pattern, value)
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("Expected <%+v> got <%+v>", expected, actual)
+		}
+	}
+
+	check(`(?P<key>\w+)=(?P<value>\w*)`, "level=warn user= id=7", []map[string]string{
+		{"0": "level=warn", "1": "level", "2": "warn", "key": "level", "value": "warn"},
+		{"0": "user=", "1": "user", "2": "", "key": "user", "value": ""},
+		{"0": "id=7", "1": "id", "2": "7", "key": "id", "value": "7"},
+	})
+	check(`(?P<key>\w+)=`, "nothing here", []map[string]string{})
+}
+
+func TestReplaceMatches(t *testing.T) {
+
+	check := func(pattern, value string, replace func(map[string]string) string, expected string) {
+		actual := ReplaceMatches(pattern, value, replace)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	swap := func(groups map[string]string) string {
+		return groups["last"] + " " + groups["first"]
+	}
+	upper := func(groups map[string]string) string {
+		return Upper(groups["0"])
+	}
+
+	check(`(?P<first>\w+) (?P<last>\w+)`, "Ada Lovelace, Alan Turing", swap, "Lovelace Ada, Turing Alan")
+	check(`\d+`, "room 101, floor 3", func(groups map[string]string) string { return "#" }, "room #, floor #")
+	check(`[aeiou]`, "gopher", upper, "gOphEr")
+	check(`x*`, "abc", func(map[string]string) string { return "-" }, "-a-b-c-")
+	check(`z`, "abc", upper, "abc")
+}
+
+func TestIsMatch(t *testing.T) {
+
+	check := func(patterns interface{}, value string, expected bool) {
+		actual := IsMatch(patterns, value)
+		if actual != expected {
+			t.Errorf("Expected <%t> got <%t>", expected, actual)
+		}
+	}
+
+	check(`^\d+$`, "12345", true)
+	check(`^\d+$`, "123a5", false)
+	check([]string{`^ERROR`, `^WARN`}, "WARN disk almost full", true)
+	check([]string{`^ERROR`, `^WARN`}, "INFO started", false)
+	check([]string{`(`, `started$`}, "INFO started", true)
+	check(`(`, "(", false)
+	check([]string{}, "", false)
+	check(42, "42", false)
+}
+
 func TestNumbers(t *testing.T) {
 
 	check := func(value, expected string) {
@@ -610,6 +775,55 @@ func TestPassword(t *testing.T) {
 	check(2048, true, true)
 }
 
+// A reader that always fails, standing in for a broken random source.
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("entropy exhausted")
+}
+
+func TestPasswordE(t *testing.T) {
+	if password, err := PasswordE(16, true, true); err != nil || len(password) != 16 {
+		t.Errorf("Expected a password of length <16> got <%s> <%v>", password, err)
+	}
+	if _, err := PasswordE(-1, true, true); err == nil {
+		t.Errorf("Expected an error for a negative length")
+	}
+
+	randomSource = failingReader{}
+	defer func() { randomSource = rand.Reader }()
+
+	if password, err := PasswordE(16, true, true); err == nil || password != "" {
+		t.Errorf("Expected the random source error got <%s> <%v>", password, err)
+	}
+	if password, err := PasswordE(0, true, true); err != nil || password != "" {
+		t.Errorf("Expected an empty password got <%s> <%v>", password, err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected Password to panic when the random source fails")
+		}
+	}()
+	Password(16, true, true)
+}
+
+func TestRandomE(t *testing.T) {
+	if str, err := RandomE(32); err != nil || !regexp.MustCompile(`^[a-zA-Z0-9]{32}$`).MatchString(str) {
+		t.Errorf("Expected 32 alphanumeric chars got <%s> <%v>", str, err)
+	}
+	if _, err := RandomE(-1); err == nil {
+		t.Errorf("Expected an error for a negative length")
+	}
+
+	randomSource = failingReader{}
+	defer func() { randomSource = rand.Reader }()
+
+	if str, err := RandomE(8); err == nil || str != "" {
+		t.Errorf("Expected the random source error got <%s> <%v>", str, err)
+	}
+}
+
 func TestRandom(t *testing.T) {
 
 	check := func(length int) {
@@ -632,6 +846,21 @@ func TestRandom(t *testing.T) {
 	check(2048)
 }
 
+func TestReverse(t *testing.T) {
+
+	check := func(value, expected string) {
+		actual := Reverse(value)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("", "")
+	check("raBoof", "fooBar")
+	check("őtüzsineT", "Teniszütő")
+	check("❤MultiByte☆", "☆etyBitluM❤")
+}
+
 func TestSlug(t *testing.T) {
 
 	check := func(value string, dictionary map[string]string, expected string) {
@@ -662,6 +891,8 @@ func TestSlug(t *testing.T) {
 	check("500-$--bill", overrides, "500-dollar-bill")
 	check("500-$-bill!", overrides, "500-dollar-bill-bang")
 	check("500-£-bill-xxx-%", overrides, "500-pound-bill-123-percent")
+	check("Crème brûlée", overrides, "creme-brulee")
+	check("Żółta łódka", overrides, "zolta-lodka")
 }
 
 func TestSquish(t *testing.T) {
@@ -724,6 +955,37 @@ func TestStudly(t *testing.T) {
 	check("foo-bar-baz", "FooBarBaz")
 	check("foo_bar_baz", "FooBarBaz")
 	check("fooBarBaz", "FooBarBaz")
+	check("http_server_id", "HTTPServerID")
+	check("http_url", "HTTPURL")
+	check("HTTPServerID", "HTTPServerID")
+	check("foo.bar", "FooBar")
+	check("  foo  bar  ", "FooBar")
+	check("", "")
+}
+
+func TestCaseRoundTrip(t *testing.T) {
+
+	check := func(value string) {
+		snake := Snake(value)
+		if actual := Snake(Camel(snake)); actual != snake {
+			t.Errorf("Expected <%s> got <%s>", snake, actual)
+		}
+		if actual := Snake(Studly(snake)); actual != snake {
+			t.Errorf("Expected <%s> got <%s>", snake, actual)
+		}
+		if actual := Snake(Kebab(snake)); actual != snake {
+			t.Errorf("Expected <%s> got <%s>", snake, actual)
+		}
+	}
+
+	check("HTTPServerID")
+	check("fooBarBaz")
+	check("foo.bar/baz")
+	check("ŻółtaŁódka")
+	check("foo1Bar")
+	check("userIDs")
+	check("ListAPIs")
+	check("URLs")
 }
 
 func TestTake(t *testing.T) {
@@ -0,0 +1,497 @@
+package str
+
+// Stringable wraps a string value so that the package functions can be chained.
+type Stringable struct {
+	value string
+}
+
+// Create a new Stringable instance from the given value.
+func Of(value string) Stringable {
+	return Stringable{value: value}
+}
+
+// Get the underlying string value.
+func (s Stringable) String() string {
+	return s.value
+}
+
+// Convert the string to Ada case.
+func (s Stringable) Ada() Stringable {
+	return Of(Ada(s.value))
+}
+
+// Return the remainder of the string after the first occurrence of a given value.
+func (s Stringable) After(search string) Stringable {
+	return Of(After(s.value, search))
+}
+
+// Return the remainder of the string after the last occurrence of a given value.
+func (s Stringable) AfterLast(search string) Stringable {
+	return Of(AfterLast(s.value, search))
+}
+
+// Lay out the string as paragraphs of the given display width with the given alignment.
+func (s Stringable) Align(width int, align Alignment) Stringable {
+	return Of(Align(s.value, width, align))
+}
+
+// Append the given values to the string.
+func (s Stringable) Append(values ...string) Stringable {
+	for _, v := range values {
+		s.value += v
+	}
+	return s
+}
+
+// Transliterate the string to ASCII.
+func (s Stringable) Ascii(lang string) Stringable {
+	return Of(Ascii(s.value, lang))
+}
+
+// Get the portion of the string before the first occurrence of a given value.
+func (s Stringable) Before(search string) Stringable {
+	return Of(Before(s.value, search))
+}
+
+// Get the portion of the string before the last occurrence of a given value.
+func (s Stringable) BeforeLast(search string) Stringable {
+	return Of(BeforeLast(s.value, search))
+}
+
+// Get the portion of the string between two given values.
+func (s Stringable) Between(from, to string) Stringable {
+	return Of(Between(s.value, from, to))
+}
+
+// Get the smallest possible portion of the string between two given values.
+func (s Stringable) BetweenFirst(from, to string) Stringable {
+	return Of(BetweenFirst(s.value, from, to))
+}
+
+// Convert the string to camel case.
+func (s Stringable) Camel() Stringable {
+	return Of(Camel(s.value))
+}
+
+// Convert the string to COBOL case.
+func (s Stringable) Cobol() Stringable {
+	return Of(Cobol(s.value))
+}
+
+// Convert the string to constant case.
+func (s Stringable) Constant() Stringable {
+	return Of(Constant(s.value))
+}
+
+// Determine if the string contains a given substring.
+func (s Stringable) Contains(substr string) bool {
+	return Contains(s.value, substr)
+}
+
+// Convert the string to the given case style.
+func (s Stringable) ConvertCase(c Case) Stringable {
+	return Of(ConvertCase(s.value, c))
+}
+
+// Determine if the string does not contain a given substring.
+func (s Stringable) DoesntContain(substr string) bool {
+	return DoesntContain(s.value, substr)
+}
+
+// Convert the string to dot case.
+func (s Stringable) Dot() Stringable {
+	return Of(Dot(s.value))
+}
+
+// Determine if the string ends with a given substring, or any of the given slice of strings.
+func (s Stringable) EndsWith(needles interface{}) bool {
+	return EndsWith(s.value, needles)
+}
+
+// Extract an excerpt from the string around the first case-insensitive match of a phrase.
+func (s Stringable) Excerpt(phrase string, radius int, omission string) Stringable {
+	return Of(Excerpt(s.value, phrase, radius, omission))
+}
+
+// Cap the string with a single instance of a given value.
+func (s Stringable) Finish(cap string) Stringable {
+	return Of(Finish(s.value, cap))
+}
+
+// Get the number of user-perceived characters in the string.
+func (s Stringable) GraphemeLength() int {
+	return GraphemeLength(s.value)
+}
+
+// Limit the number of user-perceived characters in the string.
+func (s Stringable) GraphemeLimit(limit int) Stringable {
+	return Of(GraphemeLimit(s.value, limit))
+}
+
+// Mask a portion of the string with a repeated character, counted in user-perceived characters.
+func (s Stringable) GraphemeMask(character string, index, length int) Stringable {
+	return Of(GraphemeMask(s.value, character, index, length))
+}
+
+// Reverse the user-perceived characters of the string.
+func (s Stringable) GraphemeReverse() Stringable {
+	return Of(GraphemeReverse(s.value))
+}
+
+// Return the portion of the string specified by the start and length parameters, counted in user-perceived characters.
+func (s Stringable) GraphemeSubstr(start, length int) Stringable {
+	return Of(GraphemeSubstr(s.value, start, length))
+}
+
+// Take the first or last {limit} user-perceived characters of the string.
+func (s Stringable) GraphemeTake(limit int) Stringable {
+	return Of(GraphemeTake(s.value, limit))
+}
+
+// Convert the string to a headline following the given style guide.
+func (s Stringable) Headline(style HeadlineStyle) Stringable {
+	return Of(Headline(s.value, style))
+}
+
+// Wrap every case-insensitive occurrence of any of the terms in the string.
+func (s Stringable) Highlight(terms []string, before, after string) Stringable {
+	return Of(Highlight(s.value, terms, before, after))
+}
+
+// Wrap every case-insensitive occurrence of any of the terms in the string using the given options.
+func (s Stringable) HighlightWith(terms []string, before, after string, opts HighlightOptions) Stringable {
+	return Of(HighlightWith(s.value, terms, before, after, opts))
+}
+
+// Insert a marker, a soft hyphen when empty, where the English words of the string may be hyphenated.
+func (s Stringable) Hyphenate(marker string) Stringable {
+	return Of(Hyphenate(s.value, marker))
+}
+
+// Determine if the string matches a given pattern.
+func (s Stringable) Is(patterns interface{}) bool {
+	return Is(patterns, s.value)
+}
+
+// Determine if the string is 7 bit ASCII.
+func (s Stringable) IsAscii() bool {
+	return IsAscii(s.value)
+}
+
+// Determine if the string is empty.
+func (s Stringable) IsEmpty() bool {
+	return len(s.value) == 0
+}
+
+// Determine if the string is valid JSON.
+func (s Stringable) IsJSON() bool {
+	return IsJSON(s.value)
+}
+
+// Determine if the string matches a regular expression, or any of the given slice of regular expressions.
+func (s Stringable) IsMatch(patterns interface{}) bool {
+	return IsMatch(patterns, s.value)
+}
+
+// Determine if the string is not empty.
+func (s Stringable) IsNotEmpty() bool {
+	return !s.IsEmpty()
+}
+
+// Determine if the string is a valid ULID.
+func (s Stringable) IsULID() bool {
+	return IsULID(s.value)
+}
+
+// Determine if the string is a valid URL.
+func (s Stringable) IsUrl() bool {
+	return IsUrl(s.value)
+}
+
+// Determine if the string is a valid UUID, of one of the given versions if any.
+func (s Stringable) IsUUID(versions ...int) bool {
+	return IsUUID(s.value, versions...)
+}
+
+// Convert the string to kebab case.
+func (s Stringable) Kebab() Stringable {
+	return Of(Kebab(s.value))
+}
+
+// Make the string's first character lowercase.
+func (s Stringable) Lcfirst() Stringable {
+	return Of(Lcfirst(s.value))
+}
+
+// Get the length of the string.
+func (s Stringable) Length() int {
+	return Length(s.value)
+}
+
+// Get the Levenshtein distance between the string and another.
+func (s Stringable) Levenshtein(other string) int {
+	return Levenshtein(s.value, other)
+}
+
+// Limit the number of characters in the string.
+func (s Stringable) Limit(limit int) Stringable {
+	return Of(Limit(s.value, limit))
+}
+
+// Limit the number of characters in the string using the given options.
+func (s Stringable) LimitWith(limit int, opts LimitOptions) Stringable {
+	return Of(LimitWith(s.value, limit, opts))
+}
+
+// Convert the string to lower-case.
+func (s Stringable) Lower() Stringable {
+	return Of(Lower(s.value))
+}
+
+// Mask a portion of the string with a repeated character.
+func (s Stringable) Mask(character string, index, length int) Stringable {
+	return Of(Mask(s.value, character, index, length))
+}
+
+// Get the string matching the given pattern. Panics if the pattern is invalid.
+func (s Stringable) Match(pattern string) Stringable {
+	return Of(Match(pattern, s.value))
+}
+
+// Get all strings matching the given pattern. Panics if the pattern is invalid.
+func (s Stringable) MatchAll(pattern string) []string {
+	return MatchAll(pattern, s.value)
+}
+
+// Get the capture groups of the first match of the given pattern. Panics if the pattern is invalid.
+func (s Stringable) MatchGroups(pattern string) map[string]string {
+	return MatchGroups(pattern, s.value)
+}
+
+// Remove all non-numeric characters from the string.
+func (s Stringable) Numbers() Stringable {
+	return Of(Numbers(s.value))
+}
+
+// Pad both sides of the string with another.
+func (s Stringable) PadBoth(length int, pad string) Stringable {
+	return Of(PadBoth(s.value, length, pad))
+}
+
+// Pad the left side of the string with another.
+func (s Stringable) PadLeft(length int, pad string) Stringable {
+	return Of(PadLeft(s.value, length, pad))
+}
+
+// Pad the right side of the string with another.
+func (s Stringable) PadRight(length int, pad string) Stringable {
+	return Of(PadRight(s.value, length, pad))
+}
+
+// Convert the string to path case.
+func (s Stringable) Path() Stringable {
+	return Of(Path(s.value))
+}
+
+// Get the plural form of the string, or the string itself when count is 1 or -1.
+func (s Stringable) Plural(count int) Stringable {
+	return Of(Plural(s.value, count))
+}
+
+// Pluralize the last word of the studly or camel cased string.
+func (s Stringable) PluralStudly(count int) Stringable {
+	return Of(PluralStudly(s.value, count))
+}
+
+// Prepend the given values to the string.
+func (s Stringable) Prepend(values ...string) Stringable {
+	prefix := ""
+	for _, v := range values {
+		prefix += v
+	}
+	return Of(prefix + s.value)
+}
+
+// Replace every match of the given pattern with the result of calling replace with its capture groups.
+func (s Stringable) ReplaceMatches(pattern string, replace func(groups map[string]string) string) Stringable {
+	return Of(ReplaceMatches(pattern, s.value, replace))
+}
+
+// Reverse the characters of the string.
+func (s Stringable) Reverse() Stringable {
+	return Of(Reverse(s.value))
+}
+
+// Convert the string to sentence case.
+func (s Stringable) Sentence() Stringable {
+	return Of(Sentence(s.value))
+}
+
+// Get the similarity of the string to another from 0 to 1.
+func (s Stringable) Similarity(other string) float64 {
+	return Similarity(s.value, other)
+}
+
+// Get the singular form of the string.
+func (s Stringable) Singular() Stringable {
+	return Of(Singular(s.value))
+}
+
+// Generate a URL friendly "slug" from the string.
+func (s Stringable) Slug(overrides map[string]string) Stringable {
+	return Of(Slug(s.value, overrides))
+}
+
+// Generate a URL friendly "slug" from the string using the given options.
+func (s Stringable) SlugWith(opts SlugOptions) Stringable {
+	return Of(SlugWith(s.value, opts))
+}
+
+// Convert the string to snake case.
+func (s Stringable) Snake() Stringable {
+	return Of(Snake(s.value))
+}
+
+// Determine if the string sounds like another name. See SoundsLike.
+func (s Stringable) SoundsLike(other string) bool {
+	return SoundsLike(s.value, other)
+}
+
+// Remove all "extra" blank space from the string.
+func (s Stringable) Squish() Stringable {
+	return Of(Squish(s.value))
+}
+
+// Determine if the string starts with a given substring, or any of the given slice of strings.
+func (s Stringable) StartsWith(needles interface{}) bool {
+	return StartsWith(s.value, needles)
+}
+
+// Convert the string to studly case.
+func (s Stringable) Studly() Stringable {
+	return Of(Studly(s.value))
+}
+
+// Return the portion of the string specified by the start and length parameters.
+func (s Stringable) Substr(start, length int) Stringable {
+	return Of(Substr(s.value, start, length))
+}
+
+// Take the first or last {limit} characters of the string.
+func (s Stringable) Take(limit int) Stringable {
+	return Of(Take(s.value, limit))
+}
+
+// Convert the string to title case.
+func (s Stringable) Title() Stringable {
+	return Of(Title(s.value))
+}
+
+// Convert the string to train case.
+func (s Stringable) Train() Stringable {
+	return Of(Train(s.value))
+}
+
+// Remove all whitespace from both ends of the string.
+func (s Stringable) Trim() Stringable {
+	return Of(Trim(s.value))
+}
+
+// Remove all whitespace from the beginning of the string.
+func (s Stringable) TrimLeft() Stringable {
+	return Of(TrimLeft(s.value))
+}
+
+// Remove all whitespace from the end of the string.
+func (s Stringable) TrimRight() Stringable {
+	return Of(TrimRight(s.value))
+}
+
+// Make the string's first character uppercase.
+func (s Stringable) Ucfirst() Stringable {
+	return Of(Ucfirst(s.value))
+}
+
+// Unwrap the string with the given strings.
+func (s Stringable) Unwrap(before, after string) Stringable {
+	return Of(Unwrap(s.value, before, after))
+}
+
+// Convert the string to upper-case.
+func (s Stringable) Upper() Stringable {
+	return Of(Upper(s.value))
+}
+
+// Get the display width of the string in a monospace terminal.
+func (s Stringable) Width() int {
+	return Width(s.value)
+}
+
+// Limit the display width of the string.
+func (s Stringable) WidthLimit(width int) Stringable {
+	return Of(WidthLimit(s.value, width))
+}
+
+// Pad both sides of the string with another to the given display width.
+func (s Stringable) WidthPadBoth(width int, pad string) Stringable {
+	return Of(WidthPadBoth(s.value, width, pad))
+}
+
+// Pad the left side of the string with another to the given display width.
+func (s Stringable) WidthPadLeft(width int, pad string) Stringable {
+	return Of(WidthPadLeft(s.value, width, pad))
+}
+
+// Pad the right side of the string with another to the given display width.
+func (s Stringable) WidthPadRight(width int, pad string) Stringable {
+	return Of(WidthPadRight(s.value, width, pad))
+}
+
+// Take the leading or trailing characters of the string that fit within the given display width.
+func (s Stringable) WidthTake(width int) Stringable {
+	return Of(WidthTake(s.value, width))
+}
+
+// Limit the number of words in the string.
+func (s Stringable) Words(words int) Stringable {
+	return Of(Words(s.value, words))
+}
+
+// Limit the number of words in the string using the given options.
+func (s Stringable) WordsWith(words int, opts WordsOptions) Stringable {
+	return Of(WordsWith(s.value, words, opts))
+}
+
+// Wrap the string to the given display width on word boundaries.
+func (s Stringable) WordWrap(width int, opts WordWrapOptions) Stringable {
+	return Of(WordWrap(s.value, width, opts))
+}
+
+// Wrap the string with the given strings.
+func (s Stringable) Wrap(before, after string) Stringable {
+	return Of(Wrap(s.value, before, after))
+}
+
+// Apply the callback if the given condition is true.
+func (s Stringable) When(condition bool, callback func(Stringable) Stringable) Stringable {
+	if condition {
+		return callback(s)
+	}
+	return s
+}
+
+// Apply the callback unless the given condition is true.
+func (s Stringable) Unless(condition bool, callback func(Stringable) Stringable) Stringable {
+	return s.When(!condition, callback)
+}
+
+// Call the given callback with the string value and return a new instance from the result.
+func (s Stringable) Pipe(callback func(string) string) Stringable {
+	return Of(callback(s.value))
+}
+
+// Call the given callback with the instance, then return the instance unchanged.
+func (s Stringable) Tap(callback func(Stringable)) Stringable {
+	callback(s)
+	return s
+}
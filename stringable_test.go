@@ -0,0 +1,103 @@
+package str
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOf(t *testing.T) {
+
+	check := func(actual Stringable, expected string) {
+		if actual.String() != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual.String())
+		}
+	}
+
+	check(Of(""), "")
+	check(Of("foo"), "foo")
+	check(Of("key:  Foo   BAR  ").After(":").Squish().Lower(), "foo bar")
+	check(Of("foo bar baz").Studly().Snake(), "foo_bar_baz")
+	check(Of("chris@example.com").Mask("*", 3, 8), "chr********le.com")
+	check(Of("[a]ab[b]").Between("[", "]"), "a]ab[b")
+	check(Of("Lorem ipsum dolor").Limit(5).Upper(), "LOREM...")
+	check(Of("Chris").PadLeft(6, "-").PadRight(7, "-"), "-Chris-")
+	check(Of("value").Wrap("`", "").Unwrap("`", ""), "value")
+	check(Of("500$ bill").Slug(map[string]string{"$": "-dollar-"}), "500-dollar-bill")
+	check(Of("bar").Prepend("foo", "-").Append("-", "baz"), "foo-bar-baz")
+	check(Of("chr15k").Numbers().Finish("!"), "15!")
+	check(Of("fooBar").ConvertCase(CaseConstant).Dot(), "foo.bar")
+	check(Of("ProductCategory").PluralStudly(2).Snake(), "product_categories")
+	check(Of("Children").Singular().Plural(1), "Child")
+	check(Of("This is my name").Excerpt("MY", 3, "...").Upper(), "...IS MY...")
+	check(Of("Tom & Jerry").Highlight([]string{"tom"}, "*", "").Append("!"), "*Tom* & Jerry!")
+	check(Of("Café").HighlightWith([]string{"cafe"}, "[", "]", HighlightOptions{IgnoreAccents: true}), "[Café]")
+	check(Of("foo bar baz qux").WordsWith(2, WordsOptions{Truncate: TruncateMiddle, End: "…"}).Upper(), "FOO … QUX")
+	check(Of("/var/log/nginx/access.log").LimitWith(12, LimitOptions{Truncate: TruncateStart, End: "…"}), "…x/access.log")
+	check(Of("hyphenation").Hyphenate("|").Upper(), "HY|PHEN|ATION")
+	check(Of("v1.2").ReplaceMatches(`\d+`, func(g map[string]string) string { return g["0"] + "0" }).Upper(), "V10.20")
+	check(Of("Internationalization matters").LimitWith(10, LimitOptions{Hyphenate: true}), "Interna-...")
+}
+
+func TestStringableTerminals(t *testing.T) {
+
+	s := Of("foo bar")
+
+	if !s.Contains("bar") || s.DoesntContain("bar") {
+		t.Errorf("Expected <foo bar> to contain <bar>")
+	}
+	if !s.StartsWith("foo") || !s.EndsWith([]string{"baz", "bar"}) {
+		t.Errorf("Expected <foo bar> to start with <foo> and end with <bar>")
+	}
+	if !s.Is("foo*") {
+		t.Errorf("Expected <foo bar> to match <foo*>")
+	}
+	if s.Length() != 7 {
+		t.Errorf("Expected <%d> got <%d>", 7, s.Length())
+	}
+	if s.Levenshtein("foo baz") != 1 || s.Similarity("foo bar") != 1 {
+		t.Errorf("Expected <foo bar> to be one edit from <foo baz>")
+	}
+	if groups := Of("id=7").MatchGroups(`(?P<key>\w+)=(?P<value>\d+)`); groups["key"] != "id" || groups["value"] != "7" {
+		t.Errorf("Expected groups <id> and <7> got <%+v>", groups)
+	}
+	if uuid := Of(UUIDv7()); !uuid.IsUUID(7) || uuid.IsUUID(4) {
+		t.Errorf("Expected <%s> to be a version 7 UUID only", uuid)
+	}
+	if !Of("WARN low disk").IsMatch([]string{`^ERROR`, `^WARN`}) || Of("INFO").IsMatch(`^WARN`) {
+		t.Errorf("Expected IsMatch to match any of the patterns")
+	}
+	if !Of("Müller").SoundsLike("Mueller") || Of("Müller").SoundsLike("Smith") {
+		t.Errorf("Expected <Müller> to sound like <Mueller> only")
+	}
+	if s.IsEmpty() || !Of("").IsEmpty() || !s.IsNotEmpty() {
+		t.Errorf("Expected emptiness checks to match value")
+	}
+	if actual := Of("seafood fool").MatchAll(`foo.?`); !reflect.DeepEqual(actual, []string{"food", "fool"}) {
+		t.Errorf("Expected <%+v> got <%+v>", []string{"food", "fool"}, actual)
+	}
+}
+
+func TestStringableCombinators(t *testing.T) {
+
+	check := func(actual Stringable, expected string) {
+		if actual.String() != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual.String())
+		}
+	}
+
+	upper := func(s Stringable) Stringable {
+		return s.Upper()
+	}
+
+	check(Of("foo").When(true, upper), "FOO")
+	check(Of("foo").When(false, upper), "foo")
+	check(Of("foo").Unless(true, upper), "foo")
+	check(Of("foo").Unless(false, upper), "FOO")
+	check(Of("foo").Pipe(func(s string) string { return s + "bar" }), "foobar")
+
+	tapped := ""
+	check(Of("foo").Tap(func(s Stringable) { tapped = s.Upper().String() }), "foo")
+	if tapped != "FOO" {
+		t.Errorf("Expected <%s> got <%s>", "FOO", tapped)
+	}
+}
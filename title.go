@@ -0,0 +1,180 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/chr15k/go-strings/internal/utils"
+)
+
+// HeadlineStyle selects the style guide used by Headline.
+type HeadlineStyle int
+
+const (
+	HeadlineAP      HeadlineStyle = iota // Associated Press
+	HeadlineAPA                          // American Psychological Association
+	HeadlineChicago                      // Chicago Manual of Style
+	HeadlineMLA                          // Modern Language Association
+)
+
+var headlineArticles = map[string]bool{
+	"a": true, "an": true, "the": true,
+}
+
+var headlineConjunctions = map[string]bool{
+	"and": true, "but": true, "for": true, "nor": true, "or": true, "so": true, "yet": true,
+}
+
+// Chicago capitalizes "so" and "yet", lower-casing only the remaining coordinating conjunctions.
+var headlineChicagoConjunctions = map[string]bool{
+	"and": true, "but": true, "for": true, "nor": true, "or": true,
+}
+
+var headlinePrepositions = map[string]bool{
+	"about": true, "above": true, "across": true, "after": true, "against": true,
+	"along": true, "among": true, "around": true, "as": true, "at": true,
+	"before": true, "behind": true, "below": true, "beneath": true, "beside": true,
+	"between": true, "beyond": true, "by": true, "down": true, "during": true,
+	"except": true, "for": true, "from": true, "in": true, "inside": true,
+	"into": true, "like": true, "near": true, "of": true, "off": true,
+	"on": true, "onto": true, "out": true, "outside": true, "over": true,
+	"past": true, "per": true, "since": true, "through": true, "throughout": true,
+	"till": true, "to": true, "toward": true, "towards": true, "under": true,
+	"underneath": true, "until": true, "up": true, "upon": true, "via": true,
+	"with": true, "within": true, "without": true,
+}
+
+// Determine if a lower-case word stays lower-case in the middle of a headline.
+func (style HeadlineStyle) isMinor(word string) bool {
+	switch style {
+	case HeadlineChicago:
+		return headlineArticles[word] || headlinePrepositions[word] || headlineChicagoConjunctions[word]
+	case HeadlineMLA:
+		return headlineArticles[word] || headlinePrepositions[word] || headlineConjunctions[word]
+	}
+
+	// AP and APA capitalize every word of four letters or more.
+	if Length(word) > 3 {
+		return false
+	}
+
+	return headlineArticles[word] || headlinePrepositions[word] || headlineConjunctions[word]
+}
+
+// Convert the given string to title case, capitalizing the first letter of every word.
+func Title(value string) string {
+	var builder strings.Builder
+	builder.Grow(len(value))
+
+	prev := ' '
+	for _, r := range value {
+		if startsWord(prev) {
+			builder.WriteRune(unicode.ToTitle(r))
+		} else {
+			builder.WriteRune(unicode.ToLower(r))
+		}
+		if !unicode.IsMark(r) {
+			prev = r
+		}
+	}
+
+	return builder.String()
+}
+
+// Determine if the rune following prev starts a new word.
+func startsWord(prev rune) bool {
+	return !unicode.IsLetter(prev) && !unicode.IsDigit(prev) && prev != '\'' && prev != '’'
+}
+
+// Convert the given string to a headline following the given style guide.
+//
+// Minor words (articles, conjunctions and prepositions, as defined by the style)
+// are lower-cased unless they start the headline, follow a colon or end the
+// headline (AP, Chicago and MLA). Each part of a hyphenated compound is treated
+// as a word, and underscores separate words. A value without spaces, such as
+// "EmailNotificationSent", is split into words as Studly does.
+//
+// Words that already contain capitals after their first letter, such as "iPhone"
+// or "NASA", are left untouched, unless the whole value is upper-case.
+func Headline(value string, style HeadlineStyle) string {
+	if value == Upper(value) {
+		value = Lower(value)
+	}
+
+	fields := headlineFields(value)
+	output := make([]string, 0, len(fields))
+
+	capitalizeNext := true
+	for i, field := range fields {
+		last := i == len(fields)-1
+		parts := strings.Split(field, "-")
+
+		for j, part := range parts {
+			force := (j == 0 && capitalizeNext) ||
+				(last && j == len(parts)-1 && style != HeadlineAPA)
+			parts[j] = headlineWord(part, style, force)
+		}
+
+		output = append(output, strings.Join(parts, "-"))
+
+		trimmed := strings.TrimRight(field, `"')]}’”`)
+		capitalizeNext = EndsWith(trimmed, []string{":", "?", "!", "."})
+	}
+
+	return strings.Join(output, " ")
+}
+
+// Split a headline into whitespace separated fields, splitting identifiers
+// such as "steve_jobs" or "EmailNotificationSent" into words.
+func headlineFields(value string) []string {
+	if !strings.ContainsFunc(value, unicode.IsSpace) {
+		parts := strings.Split(value, "-")
+		for i, part := range parts {
+			identifier := !strings.ContainsFunc(part, func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+			})
+			if identifier {
+				parts[i] = strings.Join(utils.SplitWords(part), " ")
+			}
+		}
+		value = strings.Join(parts, "-")
+	}
+
+	return strings.Fields(strings.ReplaceAll(value, "_", " "))
+}
+
+// Case a single headline word.
+func headlineWord(word string, style HeadlineStyle, force bool) string {
+	runes := []rune(word)
+
+	first := -1
+	for i, r := range runes {
+		if unicode.IsLetter(r) {
+			if first >= 0 && unicode.IsUpper(r) {
+				// preserve acronyms and mixed-case words like "NASA" or "iPhone"
+				return word
+			}
+			if first < 0 {
+				first = i
+			}
+		}
+	}
+
+	if first < 0 {
+		return word
+	}
+
+	lower := Lower(word)
+	core := strings.TrimFunc(lower, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	if !force && style.isMinor(core) {
+		return lower
+	}
+
+	runes = []rune(lower)
+	runes[first] = unicode.ToTitle(runes[first])
+
+	return string(runes)
+}
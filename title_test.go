@@ -0,0 +1,77 @@
+package str
+
+import "testing"
+
+func TestTitle(t *testing.T) {
+
+	check := func(value, expected string) {
+		actual := Title(value)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("jefferson costella", "Jefferson Costella")
+	check("jefFErson coSTella", "Jefferson Costella")
+	check("a tale of two cities", "A Tale Of Two Cities")
+	check("self-report and follow_up", "Self-Report And Follow_Up")
+	check("don't stop", "Don't Stop")
+	check("żółta łódka", "Żółta Łódka")
+	check("", "")
+}
+
+func TestHeadline(t *testing.T) {
+
+	check := func(value string, style HeadlineStyle, expected string) {
+		actual := Headline(value, style)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	// AP: minor words of three letters or fewer stay lower-case
+	check("the lord of the rings", HeadlineAP, "The Lord of the Rings")
+	check("a walk through the park", HeadlineAP, "A Walk Through the Park")
+	check("what are you looking for", HeadlineAP, "What Are You Looking For")
+
+	// APA: as AP, but the last word is not forced
+	check("a walk through the park", HeadlineAPA, "A Walk Through the Park")
+	check("what are you looking for", HeadlineAPA, "What Are You Looking for")
+	check("the self-report of anxiety", HeadlineAPA, "The Self-Report of Anxiety")
+
+	// Chicago: prepositions of any length stay lower-case, "so" and "yet" do not
+	check("a walk through the park", HeadlineChicago, "A Walk through the Park")
+	check("slow yet steady", HeadlineChicago, "Slow Yet Steady")
+	check("where are you from", HeadlineChicago, "Where Are You From")
+
+	// MLA: all coordinating conjunctions stay lower-case
+	check("slow yet steady", HeadlineMLA, "Slow yet Steady")
+	check("a walk through the park", HeadlineMLA, "A Walk through the Park")
+
+	// colons start a new title
+	check("star wars: a new hope", HeadlineChicago, "Star Wars: A New Hope")
+	check("go: the complete guide", HeadlineAP, "Go: The Complete Guide")
+
+	// hyphenated compounds
+	check("state-of-the-art design", HeadlineChicago, "State-of-the-Art Design")
+	check("long-term plans", HeadlineAP, "Long-Term Plans")
+
+	// acronyms and mixed-case words are preserved
+	check("review of the new iPhone by NASA", HeadlineAP, "Review of the New iPhone by NASA")
+	check("iPhone sales in the USA", HeadlineMLA, "iPhone Sales in the USA")
+
+	// identifiers are split into words
+	check("steve_jobs", HeadlineAP, "Steve Jobs")
+	check("EmailNotificationSent", HeadlineAP, "Email Notification Sent")
+	check("listOfUserIDs", HeadlineChicago, "List of User IDs")
+	check("long-termPlans", HeadlineAP, "Long-Term Plans")
+	check("the_lord of_the_rings", HeadlineAP, "The Lord of the Rings")
+
+	// capitals are only preserved when the value is not all upper-case
+	check("THE LORD OF THE RINGS", HeadlineAP, "The Lord of the Rings")
+	check("STEVE_JOBS", HeadlineAP, "Steve Jobs")
+
+	// punctuation and whitespace
+	check("  the   (secret)  of \"life\"  ", HeadlineAP, "The (Secret) of \"Life\"")
+	check("", HeadlineAP, "")
+}
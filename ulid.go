@@ -0,0 +1,145 @@
+package str
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+	"time"
+)
+
+// Crockford's base32 alphabet, which leaves out I, L, O and U.
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// Values of the Crockford base32 digits in either case, -1 for other bytes.
+var crockfordValues = func() [256]int8 {
+	var values [256]int8
+	for i := range values {
+		values[i] = -1
+	}
+	for i := 0; i < len(crockfordAlphabet); i++ {
+		values[crockfordAlphabet[i]] = int8(i)
+		values[Lower(crockfordAlphabet[i : i+1])[0]] = int8(i)
+	}
+	return values
+}()
+
+// ULIDInfo describes a ULID parsed by ParseULID.
+type ULIDInfo struct {
+	// The 16 bytes of the ULID: a 48-bit timestamp followed by 80 random bits.
+	Bytes [16]byte
+
+	// Time the ULID was generated at, to the millisecond.
+	Time time.Time
+}
+
+// Decode a ULID from its 26 Crockford base32 digits.
+func parseULID(value string) ([16]byte, bool) {
+	var ulid [16]byte
+
+	// 26 digits hold 130 bits, so the first must not exceed 7 to fit in 128
+	if len(value) != 26 || crockfordValues[value[0]] < 0 || crockfordValues[value[0]] > 7 {
+		return ulid, false
+	}
+
+	var hi, lo uint64
+	for i := 0; i < len(value); i++ {
+		digit := crockfordValues[value[i]]
+		if digit < 0 {
+			return ulid, false
+		}
+		hi = hi<<5 | lo>>59
+		lo = lo<<5 | uint64(digit)
+	}
+
+	binary.BigEndian.PutUint64(ulid[0:8], hi)
+	binary.BigEndian.PutUint64(ulid[8:16], lo)
+
+	return ulid, true
+}
+
+// Encode a ULID as 26 upper-case Crockford base32 digits.
+func formatULID(ulid [16]byte) string {
+	hi := binary.BigEndian.Uint64(ulid[0:8])
+	lo := binary.BigEndian.Uint64(ulid[8:16])
+
+	digits := make([]byte, 26)
+	for i := len(digits) - 1; i >= 0; i-- {
+		digits[i] = crockfordAlphabet[lo&0x1f]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+
+	return string(digits)
+}
+
+// Determine if a given value is a valid ULID: 26 Crockford base32 digits, in
+// either case, the first of which is at most 7.
+func IsULID(value string) bool {
+	_, ok := parseULID(value)
+	return ok
+}
+
+// Parse a ULID, in either case, getting the time it was generated at.
+func ParseULID(value string) (ULIDInfo, error) {
+	ulid, ok := parseULID(value)
+	if !ok {
+		return ULIDInfo{}, errors.New("str: invalid ULID " + value)
+	}
+
+	return ULIDInfo{
+		Bytes: ulid,
+		Time:  time.UnixMilli(int64(uuid48(ulid[0:6]))).UTC(),
+	}, nil
+}
+
+// State of the ULID generator, so ULIDs generated in the same millisecond keep increasing.
+var ulids struct {
+	sync.Mutex
+	millis  uint64
+	entropy [10]byte
+}
+
+// Generate a ULID: a 48-bit Unix time in milliseconds followed by 80 random
+// bits, as 26 upper-case Crockford base32 digits.
+//
+// ULIDs are monotonic: within the same millisecond, this process increments
+// the random bits of the previous ULID instead of drawing new ones, so ULIDs
+// always sort in the order they were generated. Should the random bits
+// overflow, the next millisecond is used.
+// Panics if the secure random source fails.
+func ULID() string {
+	ulids.Lock()
+	defer ulids.Unlock()
+
+	millis := uint64(time.Now().UnixMilli())
+
+	if millis <= ulids.millis && incrementEntropy(&ulids.entropy) {
+		millis = ulids.millis
+	} else {
+		if _, err := io.ReadFull(randomSource, ulids.entropy[:]); err != nil {
+			panic(err)
+		}
+		millis = max(millis, ulids.millis+1)
+	}
+
+	ulids.millis = millis
+
+	var ulid [16]byte
+	binary.BigEndian.PutUint16(ulid[0:2], uint16(millis>>32))
+	binary.BigEndian.PutUint32(ulid[2:6], uint32(millis))
+	copy(ulid[6:], ulids.entropy[:])
+
+	return formatULID(ulid)
+}
+
+// Add one to big-endian random bits, reporting false when they overflow.
+func incrementEntropy(entropy *[10]byte) bool {
+	for i := len(entropy) - 1; i >= 0; i-- {
+		entropy[i]++
+		if entropy[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
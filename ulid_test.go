@@ -0,0 +1,113 @@
+package str
+
+import (
+	"crypto/rand"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestIsULID(t *testing.T) {
+
+	check := func(value string, expected bool) {
+		actual := IsULID(value)
+		if actual != expected {
+			t.Errorf("Expected <%t> got <%t> for <%s>", expected, actual, value)
+		}
+	}
+
+	check(`01GJSNW9MAF792C0XYY8RX6QFT`, true)
+	check(`01GJSNW9MAF-792C0XYY8RX6ssssss-QFT`, false)
+	check(`01gjsnw9maf792c0xyy8rx6qft`, true)
+	check(`7ZZZZZZZZZZZZZZZZZZZZZZZZZ`, true)
+	check(`00000000000000000000000000`, true)
+
+	// the first digit overflows 128 bits
+	check(`8ZZZZZZZZZZZZZZZZZZZZZZZZZ`, false)
+	check(`ZZZZZZZZZZZZZZZZZZZZZZZZZZ`, false)
+	check(`A1GJSNW9MAF792C0XYY8RX6QFT`, false)
+
+	// letters outside the Crockford alphabet
+	check(`01GJSNW9MAF792C0XYY8RX6QFI`, false)
+	check(`01GJSNW9MAF792C0XYY8RX6QFL`, false)
+	check(`01GJSNW9MAF792C0XYY8RX6QFO`, false)
+	check(`01GJSNW9MAF792C0XYY8RX6QFU`, false)
+	check(`01GJSNW9MAF792C0XYY8RX6QF`, false)
+	check(`01GJSNW9MAF792C0XYY8RX6QFTT`, false)
+	check(``, false)
+}
+
+func TestParseULID(t *testing.T) {
+
+	check := func(value string, expected time.Time) {
+		info, err := ParseULID(value)
+		if err != nil || !info.Time.Equal(expected) {
+			t.Errorf("Expected <%s> got <%s> <%v> for <%s>", expected, info.Time, err, value)
+		}
+		if formatULID(info.Bytes) != Upper(value) {
+			t.Errorf("Expected bytes of <%s> got <%s>", value, formatULID(info.Bytes))
+		}
+	}
+
+	check(`01ARZ3NDEKTSV4RRFFQ69G5FAV`, time.UnixMilli(1469922850259))
+	check(`01arz3ndektsv4rrffq69g5fav`, time.UnixMilli(1469922850259))
+	check(`00000000000000000000000000`, time.UnixMilli(0))
+	check(`7ZZZZZZZZZZZZZZZZZZZZZZZZZ`, time.UnixMilli(1<<48-1))
+
+	if _, err := ParseULID(`8ZZZZZZZZZZZZZZZZZZZZZZZZZ`); err == nil {
+		t.Errorf("Expected an error for an overflowing ULID")
+	}
+}
+
+func TestULID(t *testing.T) {
+	before := time.Now().Truncate(time.Millisecond)
+
+	ulids := make([]string, 5000)
+	for i := range ulids {
+		ulids[i] = ULID()
+		if !IsULID(ulids[i]) || ulids[i] != Upper(ulids[i]) {
+			t.Errorf("Expected an upper-case ULID got <%s>", ulids[i])
+		}
+	}
+
+	if !sort.StringsAreSorted(ulids) {
+		t.Errorf("Expected ULIDs to be generated in order")
+	}
+	for i := 1; i < len(ulids); i++ {
+		if ulids[i] == ulids[i-1] {
+			t.Errorf("Expected unique ULIDs got <%s> twice", ulids[i])
+		}
+	}
+
+	info, _ := ParseULID(ulids[0])
+	if info.Time.Before(before) || info.Time.After(time.Now().Add(time.Second)) {
+		t.Errorf("Expected the ULID time <%s> to be around <%s>", info.Time, before)
+	}
+
+	randomSource = failingReader{}
+	defer func() { randomSource = rand.Reader }()
+
+	// wait for a new millisecond, which needs fresh random bits
+	time.Sleep(2 * time.Millisecond)
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected ULID to panic when the random source fails")
+		}
+	}()
+	ULID()
+}
+
+func TestIncrementEntropy(t *testing.T) {
+	entropy := [10]byte{0, 0, 0, 0, 0, 0, 0, 0, 0x01, 0xff}
+
+	if !incrementEntropy(&entropy) || entropy != [10]byte{0, 0, 0, 0, 0, 0, 0, 0, 0x02, 0x00} {
+		t.Errorf("Expected the carry to propagate got <%v>", entropy)
+	}
+
+	entropy = [10]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	if incrementEntropy(&entropy) || entropy != [10]byte{} {
+		t.Errorf("Expected an overflow got <%v>", entropy)
+	}
+}
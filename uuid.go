@@ -0,0 +1,253 @@
+package str
+
+import (
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Namespaces for name-based UUIDs, as defined in RFC 9562.
+const (
+	UUIDNamespaceDNS  = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	UUIDNamespaceURL  = "6ba7b811-9dad-11d1-80b4-00c04fd430c8"
+	UUIDNamespaceOID  = "6ba7b812-9dad-11d1-80b4-00c04fd430c8"
+	UUIDNamespaceX500 = "6ba7b814-9dad-11d1-80b4-00c04fd430c8"
+)
+
+// UUIDVariant is the layout of a UUID, given by the high bits of its ninth byte.
+type UUIDVariant int
+
+const (
+	// Reserved for backward compatibility with the Apollo NCS, 0xxx.
+	UUIDVariantNCS UUIDVariant = iota
+
+	// The layout of RFC 9562 (and RFC 4122), 10xx.
+	UUIDVariantRFC9562
+
+	// Reserved for backward compatibility with Microsoft GUIDs, 110x.
+	UUIDVariantMicrosoft
+
+	// Reserved for future definition, 111x.
+	UUIDVariantFuture
+)
+
+// UUIDInfo describes a UUID parsed by ParseUUID.
+type UUIDInfo struct {
+	// The 16 bytes of the UUID.
+	Bytes [16]byte
+
+	// Version from 0 to 15. The nil UUID has version 0 and the max UUID version 15.
+	Version int
+
+	// Layout of the UUID.
+	Variant UUIDVariant
+
+	// Time the UUID was generated at for the time-based versions 1, 6 and 7,
+	// the zero time otherwise.
+	Time time.Time
+}
+
+// Parse a UUID in its canonical, hyphenated form.
+func parseUUID(value string) ([16]byte, bool) {
+	var uuid [16]byte
+
+	if len(value) != 36 || value[8] != '-' || value[13] != '-' || value[18] != '-' || value[23] != '-' {
+		return uuid, false
+	}
+
+	digits := value[0:8] + value[9:13] + value[14:18] + value[19:23] + value[24:36]
+	if _, err := hex.Decode(uuid[:], []byte(digits)); err != nil {
+		return uuid, false
+	}
+
+	return uuid, true
+}
+
+// Format a UUID in its canonical, hyphenated, lower-case form.
+func formatUUID(uuid [16]byte) string {
+	digits := hex.EncodeToString(uuid[:])
+	return digits[0:8] + "-" + digits[8:12] + "-" + digits[12:16] + "-" + digits[16:20] + "-" + digits[20:32]
+}
+
+// Set the version and the RFC 9562 variant bits of a UUID.
+func stampUUID(uuid *[16]byte, version byte) {
+	uuid[6] = uuid[6]&0x0f | version<<4
+	uuid[8] = uuid[8]&0x3f | 0x80
+}
+
+// Get the variant of a UUID.
+func uuidVariant(uuid [16]byte) UUIDVariant {
+	switch {
+	case uuid[8]&0x80 == 0:
+		return UUIDVariantNCS
+	case uuid[8]&0xc0 == 0x80:
+		return UUIDVariantRFC9562
+	case uuid[8]&0xe0 == 0xc0:
+		return UUIDVariantMicrosoft
+	}
+	return UUIDVariantFuture
+}
+
+// Parse a UUID in its canonical, hyphenated form, in either case, getting its
+// version, variant and, for time-based versions, the time it was generated at.
+//
+// Any variant and version are accepted; use IsUUID to validate them.
+func ParseUUID(value string) (UUIDInfo, error) {
+	uuid, ok := parseUUID(value)
+	if !ok {
+		return UUIDInfo{}, errors.New("str: invalid UUID " + value)
+	}
+
+	info := UUIDInfo{
+		Bytes:   uuid,
+		Version: int(uuid[6] >> 4),
+		Variant: uuidVariant(uuid),
+	}
+
+	if info.Variant != UUIDVariantRFC9562 {
+		return info, nil
+	}
+
+	switch info.Version {
+	case 1:
+		ticks := uint64(uuid[6]&0x0f)<<56 | uint64(uuid[7])<<48 |
+			uint64(binary.BigEndian.Uint16(uuid[4:6]))<<32 | uint64(binary.BigEndian.Uint32(uuid[0:4]))
+		info.Time = gregorianTime(ticks)
+	case 6:
+		ticks := uint64(binary.BigEndian.Uint32(uuid[0:4]))<<28 | uint64(binary.BigEndian.Uint16(uuid[4:6]))<<12 |
+			uint64(uuid[6]&0x0f)<<8 | uint64(uuid[7])
+		info.Time = gregorianTime(ticks)
+	case 7:
+		info.Time = time.UnixMilli(int64(uuid48(uuid[0:6]))).UTC()
+	}
+
+	return info, nil
+}
+
+// Get the time a number of 100-nanosecond intervals after the start of the
+// Gregorian calendar, from which version 1 and 6 UUIDs count.
+func gregorianTime(ticks uint64) time.Time {
+	// intervals from 1582-10-15 to the Unix epoch
+	const unixEpoch = 0x01b21dd213814000
+	const perSecond = 10_000_000
+
+	intervals := int64(ticks) - unixEpoch
+
+	return time.Unix(intervals/perSecond, intervals%perSecond*100).UTC()
+}
+
+// Read a big-endian 48-bit integer.
+func uuid48(b []byte) uint64 {
+	return uint64(binary.BigEndian.Uint16(b[0:2]))<<32 | uint64(binary.BigEndian.Uint32(b[2:6]))
+}
+
+// Determine if a given value is a valid UUID in its canonical, hyphenated form.
+//
+// Valid UUIDs are the nil and max UUIDs, and UUIDs of the RFC 9562 variant
+// with a version from 1 to 8. When versions are given the UUID must have one of
+// them, version 0 standing for the nil UUID and 15 for the max UUID.
+func IsUUID(value string, versions ...int) bool {
+	uuid, ok := parseUUID(value)
+	if !ok {
+		return false
+	}
+
+	version := int(uuid[6] >> 4)
+
+	switch {
+	case uuid == [16]byte{}:
+	case uuid == [16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}:
+	case version < 1 || version > 8 || uuidVariant(uuid) != UUIDVariantRFC9562:
+		return false
+	}
+
+	return len(versions) == 0 || slices.Contains(versions, version)
+}
+
+// Generate a random, version 4 UUID.
+// Panics if the secure random source fails.
+func UUID() string {
+	var uuid [16]byte
+
+	if _, err := io.ReadFull(randomSource, uuid[:]); err != nil {
+		panic(err)
+	}
+	stampUUID(&uuid, 4)
+
+	return formatUUID(uuid)
+}
+
+// Generate a name-based, version 5 UUID from the SHA-1 hash of a namespace
+// UUID, such as UUIDNamespaceDNS, and a name. The same namespace and name
+// always give the same UUID. Returns an error if the namespace is not a UUID.
+func UUIDv5(namespace, name string) (string, error) {
+	ns, ok := parseUUID(namespace)
+	if !ok {
+		return "", errors.New("str: invalid UUID namespace " + namespace)
+	}
+
+	hash := sha1.New()
+	hash.Write(ns[:])
+	hash.Write([]byte(name))
+
+	var uuid [16]byte
+	copy(uuid[:], hash.Sum(nil))
+	stampUUID(&uuid, 5)
+
+	return formatUUID(uuid), nil
+}
+
+// State of the version 7 UUID generator, so UUIDs generated in the same
+// millisecond keep increasing.
+var uuidv7 struct {
+	sync.Mutex
+	millis  uint64
+	counter uint16
+}
+
+// Generate a time-ordered, version 7 UUID, starting with the Unix time in
+// milliseconds. UUIDs generated within the same millisecond by this process
+// are ordered by a 12-bit counter that starts at a random value.
+// Panics if the secure random source fails.
+func UUIDv7() string {
+	var uuid [16]byte
+
+	if _, err := io.ReadFull(randomSource, uuid[6:]); err != nil {
+		panic(err)
+	}
+
+	uuidv7.Lock()
+
+	millis := uint64(time.Now().UnixMilli())
+
+	if millis <= uuidv7.millis {
+		millis = uuidv7.millis
+		uuidv7.counter++
+
+		// borrow the next millisecond when the counter runs out
+		if uuidv7.counter > 0x0fff {
+			millis++
+			uuidv7.counter = binary.BigEndian.Uint16(uuid[6:8]) & 0x07ff
+		}
+	} else {
+		// leave the top bit clear so the counter has room to grow
+		uuidv7.counter = binary.BigEndian.Uint16(uuid[6:8]) & 0x07ff
+	}
+
+	uuidv7.millis = millis
+	counter := uuidv7.counter
+
+	uuidv7.Unlock()
+
+	binary.BigEndian.PutUint16(uuid[0:2], uint16(millis>>32))
+	binary.BigEndian.PutUint32(uuid[2:6], uint32(millis))
+	binary.BigEndian.PutUint16(uuid[6:8], counter)
+	stampUUID(&uuid, 7)
+
+	return formatUUID(uuid)
+}
@@ -0,0 +1,171 @@
+package str
+
+import (
+	"crypto/rand"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestIsUUID(t *testing.T) {
+
+	check := func(value string, expected bool) {
+		actual := IsUUID(value)
+		if actual != expected {
+			t.Errorf("Expected <%t> got <%t>", expected, actual)
+		}
+	}
+
+	check(`fd2ac93c-6783-4fa9-830f-a32dff18fb0b`, true)
+	check(`71ebdc83-9df4-4409-ad61-00d53707a9a3`, true)
+	check(`d7115ae3-4291-4901-b5f7-343da54d8146`, true)
+	check(`03a88d85-552a-4242-8193-85fb963ba529`, true)
+	check(`b69ffe23-5a89-4d6f-99b5-65515b52c212`, true)
+	check(`713d996e-e1db-4c7d-b94a-0aa1dd1b4be7`, true)
+	check(`b487f049-2777-41cc-8164-0ed6ec30f6db`, true)
+	check(`ec3feb6d-e116-48a8-a237-0dc0724821ed`, true)
+	check(`3225d006-e67c-48d1-b4ca-acce30baca43`, true)
+	check(`283d2f17-43f6-4c6d-939f-4f95ffd5b3f0`, true)
+
+	check(`fd2ac93c-6783-830f-a32dff18fb0b`, false)
+	check(`71e-9df4-4409-ad61-00d53707a9a3`, false)
+	check(`d7115ae3-4291-4901-b5f7146`, false)
+	check(`-85fb963ba529`, false)
+	check(`12345`, false)
+	check(`713d996ee1db4c7db94a-0aa1dd1b4be7`, false)
+
+	// upper-case, nil and max UUIDs
+	check(`FD2AC93C-6783-4FA9-830F-A32DFF18FB0B`, true)
+	check(`00000000-0000-0000-0000-000000000000`, true)
+	check(`ffffffff-ffff-ffff-ffff-ffffffffffff`, true)
+
+	// versions and variants outside RFC 9562
+	check(`fd2ac93c-6783-0fa9-830f-a32dff18fb0b`, false)
+	check(`fd2ac93c-6783-9fa9-830f-a32dff18fb0b`, false)
+	check(`fd2ac93c-6783-4fa9-030f-a32dff18fb0b`, false)
+	check(`fd2ac93c-6783-4fa9-c30f-a32dff18fb0b`, false)
+	check(`fd2ac93c-6783-4fa9-830f-a32dff18fb0g`, false)
+	check(`fd2ac93c+6783-4fa9-830f-a32dff18fb0b`, false)
+}
+
+func TestIsUUIDVersions(t *testing.T) {
+
+	check := func(value string, versions []int, expected bool) {
+		actual := IsUUID(value, versions...)
+		if actual != expected {
+			t.Errorf("Expected <%t> got <%t> for <%s> <%v>", expected, actual, value, versions)
+		}
+	}
+
+	check(`fd2ac93c-6783-4fa9-830f-a32dff18fb0b`, []int{4}, true)
+	check(`fd2ac93c-6783-4fa9-830f-a32dff18fb0b`, []int{1, 7}, false)
+	check(`017f22e2-79b0-7cc3-98c4-dc0c0c07398f`, []int{4, 7}, true)
+	check(`00000000-0000-0000-0000-000000000000`, []int{0}, true)
+	check(`00000000-0000-0000-0000-000000000000`, []int{4}, false)
+	check(`ffffffff-ffff-ffff-ffff-ffffffffffff`, []int{15}, true)
+	check(`ffffffff-ffff-ffff-ffff-ffffffffffff`, []int{0, 4}, false)
+}
+
+func TestParseUUID(t *testing.T) {
+
+	check := func(value string, version int, variant UUIDVariant, expected time.Time) {
+		info, err := ParseUUID(value)
+		if err != nil {
+			t.Errorf("Expected <%s> to parse got <%s>", value, err)
+			return
+		}
+		if info.Version != version || info.Variant != variant || !info.Time.Equal(expected) {
+			t.Errorf("Expected <%d %d %s> got <%d %d %s> for <%s>", version, variant, expected, info.Version, info.Variant, info.Time, value)
+		}
+		if formatUUID(info.Bytes) != Lower(value) {
+			t.Errorf("Expected bytes of <%s> got <%s>", value, formatUUID(info.Bytes))
+		}
+	}
+
+	// examples from RFC 9562, all generated at 2022-02-22 14:22:22 -05:00
+	generated := time.Date(2022, time.February, 22, 19, 22, 22, 0, time.UTC)
+
+	check("C232AB00-9414-11EC-B3C8-9F6BDECED846", 1, UUIDVariantRFC9562, generated)
+	check("1EC9414C-232A-6B00-B3C8-9F6BDECED846", 6, UUIDVariantRFC9562, generated)
+	check("017F22E2-79B0-7CC3-98C4-DC0C0C07398F", 7, UUIDVariantRFC9562, generated)
+	check("919108f7-52d1-4320-9bac-f847db4148a8", 4, UUIDVariantRFC9562, time.Time{})
+	check("2ed6657d-e927-568b-95e1-2665a8aea6a2", 5, UUIDVariantRFC9562, time.Time{})
+	check("00000000-0000-0000-0000-000000000000", 0, UUIDVariantNCS, time.Time{})
+	check("ffffffff-ffff-ffff-ffff-ffffffffffff", 15, UUIDVariantFuture, time.Time{})
+	check("c232ab00-9414-11ec-c3c8-9f6bdeced846", 1, UUIDVariantMicrosoft, time.Time{})
+
+	for _, value := range []string{"", "c232ab00941411ecb3c89f6bdeced846", "c232ab00-9414-11ec-b3c8-9f6bdeced84", "{c232ab00-9414-11ec-b3c8-9f6bdeced846}", "z232ab00-9414-11ec-b3c8-9f6bdeced846"} {
+		if _, err := ParseUUID(value); err == nil {
+			t.Errorf("Expected an error for <%s>", value)
+		}
+	}
+}
+
+func TestUUID(t *testing.T) {
+	seen := make(map[string]bool)
+
+	for i := 0; i < 1000; i++ {
+		uuid := UUID()
+		if !IsUUID(uuid, 4) || uuid != Lower(uuid) {
+			t.Errorf("Expected a lower-case version 4 UUID got <%s>", uuid)
+		}
+		if seen[uuid] {
+			t.Errorf("Expected unique UUIDs got <%s> twice", uuid)
+		}
+		seen[uuid] = true
+	}
+
+	randomSource = failingReader{}
+	defer func() { randomSource = rand.Reader }()
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected UUID to panic when the random source fails")
+		}
+	}()
+	UUID()
+}
+
+func TestUUIDv5(t *testing.T) {
+
+	check := func(namespace, name, expected string) {
+		actual, err := UUIDv5(namespace, name)
+		if actual != expected || err != nil {
+			t.Errorf("Expected <%s> got <%s> <%v>", expected, actual, err)
+		}
+	}
+
+	check(UUIDNamespaceDNS, "python.org", "886313e1-3b8a-5372-9b90-0c9aee199e5d")
+	check(UUIDNamespaceURL, "https://example.com/", "dd2c1780-811a-5296-81c5-178a0ef488bc")
+	check("6BA7B810-9DAD-11D1-80B4-00C04FD430C8", "python.org", "886313e1-3b8a-5372-9b90-0c9aee199e5d")
+
+	if _, err := UUIDv5("not-a-uuid", "python.org"); err == nil {
+		t.Errorf("Expected an error for an invalid namespace")
+	}
+}
+
+func TestUUIDv7(t *testing.T) {
+	before := time.Now().Truncate(time.Millisecond)
+
+	uuids := make([]string, 5000)
+	for i := range uuids {
+		uuids[i] = UUIDv7()
+		if !IsUUID(uuids[i], 7) {
+			t.Errorf("Expected a version 7 UUID got <%s>", uuids[i])
+		}
+	}
+
+	if !sort.StringsAreSorted(uuids) {
+		t.Errorf("Expected version 7 UUIDs to be generated in order")
+	}
+	for i := 1; i < len(uuids); i++ {
+		if uuids[i] == uuids[i-1] {
+			t.Errorf("Expected unique UUIDs got <%s> twice", uuids[i])
+		}
+	}
+
+	info, _ := ParseUUID(uuids[0])
+	if info.Time.Before(before) || info.Time.After(time.Now().Add(time.Second)) {
+		t.Errorf("Expected the UUID time <%s> to be around <%s>", info.Time, before)
+	}
+}
@@ -0,0 +1,249 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Characters with East_Asian_Width of Wide or Fullwidth, and emoji that are
+// presented wide by default.
+var wideTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x1100, Hi: 0x115F, Stride: 1},
+		{Lo: 0x231A, Hi: 0x231B, Stride: 1},
+		{Lo: 0x2329, Hi: 0x232A, Stride: 1},
+		{Lo: 0x23E9, Hi: 0x23EC, Stride: 1},
+		{Lo: 0x23F0, Hi: 0x23F3, Stride: 3},
+		{Lo: 0x25FD, Hi: 0x25FE, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x267F, Hi: 0x267F, Stride: 1},
+		{Lo: 0x2693, Hi: 0x2693, Stride: 1},
+		{Lo: 0x26A1, Hi: 0x26A1, Stride: 1},
+		{Lo: 0x26AA, Hi: 0x26AB, Stride: 1},
+		{Lo: 0x26BD, Hi: 0x26BE, Stride: 1},
+		{Lo: 0x26C4, Hi: 0x26C5, Stride: 1},
+		{Lo: 0x26CE, Hi: 0x26CE, Stride: 1},
+		{Lo: 0x26D4, Hi: 0x26D4, Stride: 1},
+		{Lo: 0x26EA, Hi: 0x26EA, Stride: 1},
+		{Lo: 0x26F2, Hi: 0x26F3, Stride: 1},
+		{Lo: 0x26F5, Hi: 0x26F5, Stride: 1},
+		{Lo: 0x26FA, Hi: 0x26FA, Stride: 1},
+		{Lo: 0x26FD, Hi: 0x26FD, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x270A, Hi: 0x270B, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x274C, Hi: 0x274E, Stride: 2},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27B0, Hi: 0x27BF, Stride: 15},
+		{Lo: 0x2B1B, Hi: 0x2B1C, Stride: 1},
+		{Lo: 0x2B50, Hi: 0x2B55, Stride: 5},
+		{Lo: 0x2E80, Hi: 0x303E, Stride: 1},
+		{Lo: 0x3041, Hi: 0x33FF, Stride: 1},
+		{Lo: 0x3400, Hi: 0x4DBF, Stride: 1},
+		{Lo: 0x4E00, Hi: 0x9FFF, Stride: 1},
+		{Lo: 0xA000, Hi: 0xA4CF, Stride: 1},
+		{Lo: 0xA960, Hi: 0xA97F, Stride: 1},
+		{Lo: 0xAC00, Hi: 0xD7A3, Stride: 1},
+		{Lo: 0xF900, Hi: 0xFAFF, Stride: 1},
+		{Lo: 0xFE10, Hi: 0xFE19, Stride: 1},
+		{Lo: 0xFE30, Hi: 0xFE6F, Stride: 1},
+		{Lo: 0xFF00, Hi: 0xFF60, Stride: 1},
+		{Lo: 0xFFE0, Hi: 0xFFE6, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x16FE0, Hi: 0x16FE4, Stride: 1},
+		{Lo: 0x17000, Hi: 0x18AFF, Stride: 1},
+		{Lo: 0x1B000, Hi: 0x1B2FF, Stride: 1},
+		{Lo: 0x1F004, Hi: 0x1F004, Stride: 1},
+		{Lo: 0x1F0CF, Hi: 0x1F0CF, Stride: 1},
+		{Lo: 0x1F18E, Hi: 0x1F18E, Stride: 1},
+		{Lo: 0x1F191, Hi: 0x1F19A, Stride: 1},
+		{Lo: 0x1F1E6, Hi: 0x1F1FF, Stride: 1},
+		{Lo: 0x1F200, Hi: 0x1F251, Stride: 1},
+		{Lo: 0x1F300, Hi: 0x1F320, Stride: 1},
+		{Lo: 0x1F32D, Hi: 0x1F335, Stride: 1},
+		{Lo: 0x1F337, Hi: 0x1F37C, Stride: 1},
+		{Lo: 0x1F37E, Hi: 0x1F393, Stride: 1},
+		{Lo: 0x1F3A0, Hi: 0x1F3CA, Stride: 1},
+		{Lo: 0x1F3CF, Hi: 0x1F3D3, Stride: 1},
+		{Lo: 0x1F3E0, Hi: 0x1F3F0, Stride: 1},
+		{Lo: 0x1F3F4, Hi: 0x1F3F4, Stride: 1},
+		{Lo: 0x1F3F8, Hi: 0x1F43E, Stride: 1},
+		{Lo: 0x1F440, Hi: 0x1F440, Stride: 1},
+		{Lo: 0x1F442, Hi: 0x1F4FC, Stride: 1},
+		{Lo: 0x1F4FF, Hi: 0x1F53D, Stride: 1},
+		{Lo: 0x1F54B, Hi: 0x1F54E, Stride: 1},
+		{Lo: 0x1F550, Hi: 0x1F567, Stride: 1},
+		{Lo: 0x1F57A, Hi: 0x1F57A, Stride: 1},
+		{Lo: 0x1F595, Hi: 0x1F596, Stride: 1},
+		{Lo: 0x1F5A4, Hi: 0x1F5A4, Stride: 1},
+		{Lo: 0x1F5FB, Hi: 0x1F64F, Stride: 1},
+		{Lo: 0x1F680, Hi: 0x1F6C5, Stride: 1},
+		{Lo: 0x1F6CC, Hi: 0x1F6CC, Stride: 1},
+		{Lo: 0x1F6D0, Hi: 0x1F6D2, Stride: 1},
+		{Lo: 0x1F6D5, Hi: 0x1F6D7, Stride: 1},
+		{Lo: 0x1F6DC, Hi: 0x1F6DF, Stride: 1},
+		{Lo: 0x1F6EB, Hi: 0x1F6EC, Stride: 1},
+		{Lo: 0x1F6F4, Hi: 0x1F6FC, Stride: 1},
+		{Lo: 0x1F7E0, Hi: 0x1F7EB, Stride: 1},
+		{Lo: 0x1F7F0, Hi: 0x1F7F0, Stride: 1},
+		{Lo: 0x1F90C, Hi: 0x1F93A, Stride: 1},
+		{Lo: 0x1F93C, Hi: 0x1F945, Stride: 1},
+		{Lo: 0x1F947, Hi: 0x1F9FF, Stride: 1},
+		{Lo: 0x1FA70, Hi: 0x1FAFF, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2FFFD, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3FFFD, Stride: 1},
+	},
+}
+
+// Get the display width of a single rune in a monospace terminal.
+func runeWidth(r rune) int {
+	switch {
+	case r == 0:
+		return 0
+	case r < 0x20 || r >= 0x7F && r < 0xA0:
+		return 0
+	case r < 0x7F:
+		return 1
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf),
+		r >= 0x1160 && r <= 0x11FF, // Hangul medial vowels and final consonants
+		r == 0x200B:
+		return 0
+	case unicode.Is(wideTable, r):
+		return 2
+	}
+	return 1
+}
+
+// Get the display width of a grapheme cluster.
+func clusterWidth(cluster string) int {
+	width := 0
+
+	for i, r := range cluster {
+		if i == 0 {
+			width = runeWidth(r)
+			continue
+		}
+		if r == 0xFE0F && width == 1 {
+			// emoji presentation selector
+			return 2
+		}
+	}
+
+	return width
+}
+
+// Get the display width of a string in a monospace terminal.
+//
+// East Asian wide and fullwidth characters and emoji count as two columns,
+// combining marks, zero-width characters and controls as none. Each grapheme
+// cluster takes the width of its first character.
+func Width(value string) int {
+	if IsAscii(value) {
+		width := 0
+		for i := 0; i < len(value); i++ {
+			if value[i] >= 0x20 && value[i] < 0x7F {
+				width++
+			}
+		}
+		return width
+	}
+
+	width := 0
+	for _, cluster := range Graphemes(value) {
+		width += clusterWidth(cluster)
+	}
+
+	return width
+}
+
+// Build padding exactly width columns wide by repeating pad, filling any
+// column a wide pad character cannot cover with a space.
+func padToWidth(pad string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+
+	clusters := make([]string, 0)
+	for _, cluster := range Graphemes(pad) {
+		if clusterWidth(cluster) > 0 {
+			clusters = append(clusters, cluster)
+		}
+	}
+
+	var builder strings.Builder
+	filled := 0
+
+	for i := 0; len(clusters) > 0 && filled < width; i++ {
+		cluster := clusters[i%len(clusters)]
+		if filled+clusterWidth(cluster) > width {
+			break
+		}
+		builder.WriteString(cluster)
+		filled += clusterWidth(cluster)
+	}
+
+	builder.WriteString(strings.Repeat(" ", width-filled))
+
+	return builder.String()
+}
+
+// Pad both sides of a string with another to the given display width.
+func WidthPadBoth(value string, width int, pad string) string {
+	short := max(0, width-Width(value))
+
+	return padToWidth(pad, short/2) + value + padToWidth(pad, short-short/2)
+}
+
+// Pad the left side of a string with another to the given display width.
+func WidthPadLeft(value string, width int, pad string) string {
+	return padToWidth(pad, width-Width(value)) + value
+}
+
+// Pad the right side of a string with another to the given display width.
+func WidthPadRight(value string, width int, pad string) string {
+	return value + padToWidth(pad, width-Width(value))
+}
+
+// Take the leading or, for a negative width, trailing characters of a string
+// that fit within the given display width.
+func WidthTake(value string, width int) string {
+	clusters := Graphemes(value)
+
+	if width < 0 {
+		width = -width
+		used := 0
+		i := len(clusters)
+		for ; i > 0; i-- {
+			w := clusterWidth(clusters[i-1])
+			if used+w > width {
+				break
+			}
+			used += w
+		}
+		return strings.Join(clusters[i:], "")
+	}
+
+	used := 0
+	i := 0
+	for ; i < len(clusters); i++ {
+		w := clusterWidth(clusters[i])
+		if used+w > width {
+			break
+		}
+		used += w
+	}
+
+	return strings.Join(clusters[:i], "")
+}
+
+// Limit the display width of a string, not counting the trailing "...".
+func WidthLimit(value string, width int) string {
+	if Width(value) <= width || width <= 0 {
+		return value
+	}
+	return WidthTake(value, width) + "..."
+}
@@ -0,0 +1,111 @@
+package str
+
+import "testing"
+
+func TestWidth(t *testing.T) {
+
+	check := func(value string, expected int) {
+		actual := Width(value)
+		if actual != expected {
+			t.Errorf("Expected <%d> got <%d> for <%s>", expected, actual, value)
+		}
+	}
+
+	check("", 0)
+	check("chris", 5)
+	check("tab\there", 7)
+	check("这是一段中文", 12)
+	check("ｆｕｌｌ", 8)
+	check("ｶﾀｶﾅ", 4)
+	check("한국어", 6)
+	check("Jönköping", 9)
+	check("👍", 2)
+	check("👍🏽", 2)
+	check("🇬🇧", 2)
+	check("👨‍👩‍👧", 2)
+	check("❤", 1)
+	check("❤️", 2)
+	check("zero\u200bwidth", 9)
+	check("a中b", 4)
+}
+
+func TestWidthPadBoth(t *testing.T) {
+
+	check := func(value string, width int, pad, expected string) {
+		actual := WidthPadBoth(value, width, pad)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("Chris", 14, "-", "----Chris-----")
+	check("Chris", 10, "+=", "+=Chris+=+")
+	check("中文", 8, "-", "--中文--")
+	check("中文", 3, "-", "中文")
+	check("ab", 7, "中", "中ab中 ")
+}
+
+func TestWidthPadLeft(t *testing.T) {
+
+	check := func(value string, width int, pad, expected string) {
+		actual := WidthPadLeft(value, width, pad)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("Chris", 6, "-", "-Chris")
+	check("Chris", 10, "+=", "+=+=+Chris")
+	check("这是一段中文", 14, "_", "__这是一段中文")
+	check("👍🏽", 4, " ", "  👍🏽")
+	check("ab", 5, "中", "中 ab")
+	check("ab", 4, "\u0301", "  ab")
+}
+
+func TestWidthPadRight(t *testing.T) {
+
+	check := func(value string, width int, pad, expected string) {
+		actual := WidthPadRight(value, width, pad)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("Chris", 6, "-", "Chris-")
+	check("这是一段中文", 14, "_", "这是一段中文__")
+	check("中", 6, "中", "中中中")
+	check("中", 1, "-", "中")
+}
+
+func TestWidthTake(t *testing.T) {
+
+	check := func(value string, width int, expected string) {
+		actual := WidthTake(value, width)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("abcdef", 2, "ab")
+	check("abcdef", -2, "ef")
+	check("abcdef", 0, "")
+	check("这是一段中文", 5, "这是")
+	check("这是一段中文", -4, "中文")
+	check("a👍🏽b", 3, "a👍🏽")
+}
+
+func TestWidthLimit(t *testing.T) {
+
+	check := func(value string, width int, expected string) {
+		actual := WidthLimit(value, width)
+		if actual != expected {
+			t.Errorf("Expected <%s> got <%s>", expected, actual)
+		}
+	}
+
+	check("chris", 3, "chr...")
+	check("chris", 0, "chris")
+	check("chris", 5, "chris")
+	check("这是一段中文", 5, "这是...")
+	check("这是一段中文", 12, "这是一段中文")
+}
@@ -0,0 +1,124 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+)
+
+// WordWrapOptions configures WordWrap.
+type WordWrapOptions struct {
+	// Line break inserted between wrapped lines, "\n" when empty.
+	Break string
+
+	// Break words wider than the line width instead of letting them overflow.
+	CutLongWords bool
+
+	// Hyphenate a word that does not fit at the end of a line, before
+	// falling back to CutLongWords.
+	Hyphenate bool
+
+	// Language tag of the hyphenation patterns, English when empty.
+	Language string
+
+	// Prefix added, after any existing indentation, to every wrapped line
+	// except the first line of each paragraph.
+	HangingIndent string
+}
+
+// Wrap a string to the given display width on word boundaries.
+//
+// Existing newlines are preserved and each line is wrapped on its own, keeping
+// its leading indentation on every line it is wrapped onto. Runs of whitespace
+// between words collapse to a single space. A width of zero or less returns
+// the value unchanged.
+func WordWrap(value string, width int, opts WordWrapOptions) string {
+	if width <= 0 {
+		return value
+	}
+
+	lineBreak := opts.Break
+	if len(lineBreak) == 0 {
+		lineBreak = "\n"
+	}
+
+	lines := strings.Split(value, "\n")
+	output := make([]string, 0, len(lines))
+
+	for _, line := range lines {
+		output = append(output, wrapLine(strings.TrimSuffix(line, "\r"), width, opts)...)
+	}
+
+	return strings.Join(output, lineBreak)
+}
+
+// Wrap a single line of text, returning the wrapped lines.
+func wrapLine(line string, width int, opts WordWrapOptions) []string {
+	indent := line[:len(line)-len(strings.TrimLeftFunc(line, unicode.IsSpace))]
+	words := strings.Fields(line)
+
+	if len(words) == 0 {
+		return []string{""}
+	}
+
+	hyphenator := HyphenatorFor(opts.Language)
+
+	lines := make([]string, 0)
+	prefix := indent
+	current := ""
+
+	flush := func() {
+		lines = append(lines, prefix+current)
+		prefix = indent + opts.HangingIndent
+		current = ""
+	}
+
+	for _, word := range words {
+		available := max(width-Width(prefix), 1)
+
+		if len(current) > 0 {
+			if Width(current)+1+Width(word) <= available {
+				current += " " + word
+				continue
+			}
+			if opts.Hyphenate {
+				if head, tail, ok := hyphenator.split(word, available-Width(current)-1); ok {
+					current += " " + head
+					word = tail
+				}
+			}
+			flush()
+			available = max(width-Width(prefix), 1)
+		}
+
+		for opts.Hyphenate && Width(word) > available {
+			head, tail, ok := hyphenator.split(word, available)
+			if !ok {
+				break
+			}
+			current = head
+			word = tail
+			flush()
+			available = max(width-Width(prefix), 1)
+		}
+
+		for opts.CutLongWords && Width(word) > available {
+			chunk := WidthTake(word, available)
+			if len(chunk) == 0 {
+				// a single character wider than the line
+				chunk = GraphemeTake(word, 1)
+			}
+			current = chunk
+			word = word[len(chunk):]
+			flush()
+			available = max(width-Width(prefix), 1)
+		}
+
+		current = word
+	}
+
+	if len(current) > 0 {
+		flush()
+	}
+
+	return lines
+}
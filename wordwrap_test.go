@@ -0,0 +1,49 @@
+package str
+
+import "testing"
+
+func TestWordWrap(t *testing.T) {
+
+	check := func(value string, width int, opts WordWrapOptions, expected string) {
+		actual := WordWrap(value, width, opts)
+		if actual != expected {
+			t.Errorf("Expected <%q> got <%q>", expected, actual)
+		}
+	}
+
+	s := "The quick brown fox jumped over the lazy dog."
+
+	check(s, 10, WordWrapOptions{}, "The quick\nbrown fox\njumped\nover the\nlazy dog.")
+	check(s, 20, WordWrapOptions{}, "The quick brown fox\njumped over the lazy\ndog.")
+	check(s, 100, WordWrapOptions{}, s)
+	check(s, 0, WordWrapOptions{}, s)
+	check(s, 20, WordWrapOptions{Break: "<br>"}, "The quick brown fox<br>jumped over the lazy<br>dog.")
+	check("", 10, WordWrapOptions{}, "")
+
+	// long words
+	check("A very long woooooooooooord.", 8, WordWrapOptions{}, "A very\nlong\nwoooooooooooord.")
+	check("A very long woooooooooooord.", 8, WordWrapOptions{CutLongWords: true}, "A very\nlong\nwooooooo\nooooord.")
+	check("abcdefghij", 3, WordWrapOptions{CutLongWords: true}, "abc\ndef\nghi\nj")
+
+	// hyphenation
+	hyphenate := WordWrapOptions{Hyphenate: true}
+	check("Localization and internationalization", 12, hyphenate, "Localization\nand interna-\ntionaliza-\ntion")
+	check("A hyphenation example", 10, hyphenate, "A hyphen-\nation ex-\nample")
+	check("A hyphenation example", 10, WordWrapOptions{}, "A\nhyphenation\nexample")
+	check("Supercalifragilistic", 4, WordWrapOptions{Hyphenate: true, CutLongWords: true}, "Su-\nper-\ncal-\nifra\ngili\nstic")
+
+	// existing newlines and indentation
+	check("first line\n\nsecond paragraph here", 10, WordWrapOptions{}, "first line\n\nsecond\nparagraph\nhere")
+	check("  - an indented list item", 12, WordWrapOptions{}, "  - an\n  indented\n  list item")
+	check("line one\r\nline two", 20, WordWrapOptions{}, "line one\nline two")
+
+	// hanging indent
+	check("-v, --verbose  print more output while running", 20, WordWrapOptions{HangingIndent: "    "},
+		"-v, --verbose print\n    more output\n    while running")
+
+	// display width
+	check("这是 一段 中文 文本", 9, WordWrapOptions{}, "这是 一段\n中文 文本")
+	check("这是一段中文文本", 6, WordWrapOptions{CutLongWords: true}, "这是一\n段中文\n文本")
+	check("👍🏽👍🏽👍🏽", 3, WordWrapOptions{CutLongWords: true}, "👍🏽\n👍🏽\n👍🏽")
+	check("中中", 1, WordWrapOptions{CutLongWords: true}, "中\n中")
+}